@@ -1,5 +1,38 @@
 package main
 
+import (
+    "cgbemu/src/cartridge"
+    "fmt"
+    "os"
+)
+
 func main(){
 
+    if len(os.Args) != 2 {
+        fmt.Fprintln(os.Stderr, "usage: cgbemu <rom.gbc>")
+        os.Exit(2)
+    }
+
+    cart, err := cartridge.Load(os.Args[1])
+    if err != nil {
+        fmt.Fprintln(os.Stderr, err)
+        os.Exit(1)
+    }
+
+    h := cart.Header
+    fmt.Println("Title:        ", h.Title)
+    fmt.Println("Manufacturer: ", h.Manufacturer)
+    fmt.Println("CGB:          ", h.CGB)
+    fmt.Println("SGB:          ", h.SGB)
+    fmt.Println("Type:         ", h.Type)
+    if cart.Detection != nil {
+        fmt.Println("Detected:     ", cart.Detection)
+    }
+    fmt.Println("ROM size:     ", h.ROMSize)
+    fmt.Println("RAM size:     ", h.RAMSize)
+    fmt.Println("Licensee:     ", h.Licensee)
+    fmt.Println("Version:      ", h.Version)
+    if !h.GlobalChecksumValid {
+        fmt.Println("Warning: global checksum mismatch")
+    }
 }
@@ -0,0 +1,207 @@
+package alu
+
+// SM83 arithmetic and logic operations, following:
+//
+// https://gekkio.fi/files/gb-docs/gbctr.pdf
+//
+// Every operation returns its result together with the complete new value of the F
+// register. Operations leaving some flags unchanged, or using the carry, take the
+// current F value as flags. The lower four bits of the returned flags are always zero.
+
+// Flag bits of the F register.
+const (
+    Z byte = 1 << 7 // Zero.
+    N byte = 1 << 6 // Subtract (BCD).
+    H byte = 1 << 5 // Half carry (BCD), from bit 3 (bit 11 for 16-bit operations).
+    C byte = 1 << 4 // Carry, from bit 7 (bit 15 for 16-bit operations).
+)
+
+// zero returns Z if value is 0.
+func zero(value byte) byte {
+    if value == 0 {
+        return Z
+    }
+    return 0
+}
+
+// carry returns 1 if the C flag is set in flags.
+func carry(flags byte) byte {
+    return (flags & C) >> 4
+}
+
+// Add returns a + b.
+// Flags: Z = star, N = 0, H = star, C = star.
+func Add(a, b byte) (byte, byte) {
+    return Adc(a, b, 0)
+}
+
+// Adc returns a + b + carry.
+// Flags: Z = star, N = 0, H = star, C = star.
+func Adc(a, b, flags byte) (byte, byte) {
+
+    sum := uint16(a) + uint16(b) + uint16(carry(flags))
+    result := byte(sum)
+
+    // A carry from bit 3 flips bit 4 of the sum.
+    f := zero(result) | (a ^ b ^ result) << 1 & H
+    if sum > 0xFF {
+        f |= C
+    }
+    return result, f
+}
+
+// Sub returns a - b.
+// Flags: Z = star, N = 1, H = star, C = star.
+func Sub(a, b byte) (byte, byte) {
+    return Sbc(a, b, 0)
+}
+
+// Sbc returns a - b - carry.
+// Flags: Z = star, N = 1, H = star, C = star.
+func Sbc(a, b, flags byte) (byte, byte) {
+
+    difference := uint16(a) - uint16(b) - uint16(carry(flags))
+    result := byte(difference)
+
+    // A borrow from bit 4 flips it.
+    f := zero(result) | N | (a ^ b ^ result) << 1 & H
+    if difference > 0xFF {
+        f |= C
+    }
+    return result, f
+}
+
+// Cp compares a and b, subtracting b from a without keeping the result.
+// Flags: Z = star, N = 1, H = star, C = star.
+func Cp(a, b byte) byte {
+    _, f := Sub(a, b)
+    return f
+}
+
+// And returns a & b.
+// Flags: Z = star, N = 0, H = 1, C = 0.
+func And(a, b byte) (byte, byte) {
+    result := a & b
+    return result, zero(result) | H
+}
+
+// Xor returns a ^ b.
+// Flags: Z = star, N = 0, H = 0, C = 0.
+func Xor(a, b byte) (byte, byte) {
+    result := a ^ b
+    return result, zero(result)
+}
+
+// Or returns a | b.
+// Flags: Z = star, N = 0, H = 0, C = 0.
+func Or(a, b byte) (byte, byte) {
+    result := a | b
+    return result, zero(result)
+}
+
+// Inc returns value + 1.
+// Flags: Z = star, N = 0, H = star, C = unchanged.
+func Inc(value, flags byte) (byte, byte) {
+
+    result := value + 1
+
+    f := flags & C | zero(result)
+    if value & 0x0F == 0x0F {
+        f |= H
+    }
+    return result, f
+}
+
+// Dec returns value - 1.
+// Flags: Z = star, N = 1, H = star, C = unchanged.
+func Dec(value, flags byte) (byte, byte) {
+
+    result := value - 1
+
+    // The lower nibble borrows from the upper one only if it is 0.
+    f := flags & C | zero(result) | N
+    if value & 0x0F == 0x00 {
+        f |= H
+    }
+    return result, f
+}
+
+// AddWord returns a + b, as ADD HL, rr.
+// Flags: Z = unchanged, N = 0, H = star (from bit 11), C = star (from bit 15).
+func AddWord(a, b uint16, flags byte) (uint16, byte) {
+
+    sum := uint32(a) + uint32(b)
+    result := uint16(sum)
+
+    f := flags & Z
+    if (a ^ b ^ result) & 0x1000 != 0 {
+        f |= H
+    }
+    if sum > 0xFFFF {
+        f |= C
+    }
+    return result, f
+}
+
+// AddOffset returns a plus the signed offset e, as ADD SP, e and LD HL, SP+e.
+// H and C come from the unsigned addition of the lower byte of a and e.
+// Flags: Z = 0, N = 0, H = star, C = star.
+func AddOffset(a uint16, e int8) (uint16, byte) {
+
+    _, f := Add(byte(a), byte(e))
+    return a + uint16(e), f &^ Z
+}
+
+// DAA adjusts a to BCD after an addition or a subtraction of two BCD numbers,
+// using N, H and C of the previous operation.
+//
+//  LD  A, 0x09
+//  ADD A, 0x01  ; A = 0x0A
+//  DAA          ; adjusts A to 0x10, because 0x0A is invalid in BCD
+//
+// Flags: Z = star, N = unchanged, H = 0, C = star.
+func DAA(a, flags byte) (byte, byte) {
+
+    f := flags & (N | C)
+
+    if flags & N == 0 {
+
+        // After an addition, adjust each digit exceeding 9 or having carried.
+        if flags & C != 0 || a > 0x99 {
+            a += 0x60
+            f |= C
+        }
+        if flags & H != 0 || a & 0x0F > 0x09 {
+            a += 0x06
+        }
+    } else {
+
+        // After a subtraction, only the digits having borrowed are adjusted.
+        if flags & C != 0 {
+            a -= 0x60
+        }
+        if flags & H != 0 {
+            a -= 0x06
+        }
+    }
+
+    return a, f | zero(a)
+}
+
+// CPL returns a with all the bits flipped.
+// Flags: Z = unchanged, N = 1, H = 1, C = unchanged.
+func CPL(a, flags byte) (byte, byte) {
+    return ^a, flags & (Z | C) | N | H
+}
+
+// SCF sets the carry flag.
+// Flags: Z = unchanged, N = 0, H = 0, C = 1.
+func SCF(flags byte) byte {
+    return flags & Z | C
+}
+
+// CCF flips the carry flag.
+// Flags: Z = unchanged, N = 0, H = 0, C = flipped.
+func CCF(flags byte) byte {
+    return flags & Z | ^flags & C
+}
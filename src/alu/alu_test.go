@@ -0,0 +1,273 @@
+package alu
+
+import "testing"
+
+// allFlags lists every value of F with the lower four bits at zero.
+var allFlags = [16]byte{
+    0x00, 0x10, 0x20, 0x30, 0x40, 0x50, 0x60, 0x70,
+    0x80, 0x90, 0xA0, 0xB0, 0xC0, 0xD0, 0xE0, 0xF0,
+}
+
+// flagsOf builds F from the four flags.
+func flagsOf(z, n, h, c bool) byte {
+    f := byte(0)
+    for i, set := range []bool{z, n, h, c} {
+        if set {
+            f |= 0x80 >> i
+        }
+    }
+    return f
+}
+
+// binaryOperation is an 8-bit operation on two operands and the flags, with its
+// reference implementation computed on integers.
+type binaryOperation struct {
+    name        string
+    operation   func(a, b, flags byte) (byte, byte)
+    reference   func(a, b, carry int) (result int, halfCarry bool, subtract bool)
+}
+
+var binaryOperations = []binaryOperation{
+    {
+        "ADD",
+        func(a, b, flags byte) (byte, byte) { return Add(a, b) },
+        func(a, b, carry int) (int, bool, bool) { return a + b, a & 0x0F + b & 0x0F > 0x0F, false },
+    },
+    {
+        "ADC",
+        Adc,
+        func(a, b, carry int) (int, bool, bool) { return a + b + carry, a & 0x0F + b & 0x0F + carry > 0x0F, false },
+    },
+    {
+        "SUB",
+        func(a, b, flags byte) (byte, byte) { return Sub(a, b) },
+        func(a, b, carry int) (int, bool, bool) { return a - b, a & 0x0F - b & 0x0F < 0, true },
+    },
+    {
+        "SBC",
+        Sbc,
+        func(a, b, carry int) (int, bool, bool) { return a - b - carry, a & 0x0F - b & 0x0F - carry < 0, true },
+    },
+    {
+        "CP",
+        func(a, b, flags byte) (byte, byte) { return a - b, Cp(a, b) },
+        func(a, b, carry int) (int, bool, bool) { return a - b, a & 0x0F - b & 0x0F < 0, true },
+    },
+}
+
+func TestArithmeticOperationsOnAllInputs(t *testing.T) {
+
+    for _, op := range binaryOperations {
+        for a := 0; a < 256; a++ {
+            for b := 0; b < 256; b++ {
+                for _, flags := range allFlags {
+
+                    // Given
+                    carry := int(flags >> 4 & 1)
+                    sum, halfCarry, subtract := op.reference(a, b, carry)
+                    expected := byte(sum)
+                    expectedFlags := flagsOf(expected == 0, subtract, halfCarry, sum < 0 || sum > 0xFF)
+
+                    // When
+                    result, f := op.operation(byte(a), byte(b), flags)
+
+                    // Then
+                    if result != expected || f != expectedFlags {
+                        t.Fatalf("%s 0x%02X, 0x%02X with F 0x%02X: got 0x%02X F 0x%02X, expected 0x%02X F 0x%02X",
+                            op.name, a, b, flags, result, f, expected, expectedFlags)
+                    }
+                }
+            }
+        }
+    }
+}
+
+func TestLogicOperationsOnAllInputs(t *testing.T) {
+
+    operations := []struct {
+        name        string
+        operation   func(a, b byte) (byte, byte)
+        reference   func(a, b byte) byte
+        halfCarry   bool
+    }{
+        {"AND", And, func(a, b byte) byte { return a & b }, true},
+        {"XOR", Xor, func(a, b byte) byte { return a ^ b }, false},
+        {"OR", Or, func(a, b byte) byte { return a | b }, false},
+    }
+
+    for _, op := range operations {
+        for a := 0; a < 256; a++ {
+            for b := 0; b < 256; b++ {
+
+                expected := op.reference(byte(a), byte(b))
+                expectedFlags := flagsOf(expected == 0, false, op.halfCarry, false)
+
+                result, f := op.operation(byte(a), byte(b))
+
+                if result != expected || f != expectedFlags {
+                    t.Fatalf("%s 0x%02X, 0x%02X: got 0x%02X F 0x%02X, expected 0x%02X F 0x%02X",
+                        op.name, a, b, result, f, expected, expectedFlags)
+                }
+            }
+        }
+    }
+}
+
+func TestIncAndDecOnAllInputs(t *testing.T) {
+
+    for value := 0; value < 256; value++ {
+        for _, flags := range allFlags {
+
+            carry := flags & C != 0
+
+            result, f := Inc(byte(value), flags)
+            expectedFlags := flagsOf(byte(value + 1) == 0, false, value & 0x0F == 0x0F, carry)
+            if result != byte(value + 1) || f != expectedFlags {
+                t.Fatalf("INC 0x%02X with F 0x%02X: got 0x%02X F 0x%02X, expected F 0x%02X", value, flags, result, f, expectedFlags)
+            }
+
+            result, f = Dec(byte(value), flags)
+            expectedFlags = flagsOf(byte(value - 1) == 0, true, value & 0x0F == 0x00, carry)
+            if result != byte(value - 1) || f != expectedFlags {
+                t.Fatalf("DEC 0x%02X with F 0x%02X: got 0x%02X F 0x%02X, expected F 0x%02X", value, flags, result, f, expectedFlags)
+            }
+        }
+    }
+}
+
+func TestAddWord(t *testing.T) {
+
+    // Every lower and upper byte pattern of the first operand, against every upper byte of the second,
+    // with the carries out of bits 11 and 15 covered by the upper bytes.
+    for a := 0; a < 0x10000; a += 0x0101 {
+        for high := 0; high < 256; high++ {
+            for _, low := range []int{0x00, 0x01, 0xFF} {
+                for _, flags := range []byte{0x00, Z | N | H | C} {
+
+                    b := high << 8 | low
+                    sum := a + b
+                    expectedFlags := flagsOf(flags & Z != 0, false, a & 0x0FFF + b & 0x0FFF > 0x0FFF, sum > 0xFFFF)
+
+                    result, f := AddWord(uint16(a), uint16(b), flags)
+
+                    if result != uint16(sum) || f != expectedFlags {
+                        t.Fatalf("ADD 0x%04X, 0x%04X with F 0x%02X: got 0x%04X F 0x%02X, expected F 0x%02X", a, b, flags, result, f, expectedFlags)
+                    }
+                }
+            }
+        }
+    }
+}
+
+func TestAddOffsetOnAllInputs(t *testing.T) {
+
+    for _, high := range []int{0x00, 0x7F, 0xFF} {
+        for low := 0; low < 256; low++ {
+            for e := -128; e < 128; e++ {
+
+                a := high << 8 | low
+                expected := uint16(a + e)
+
+                // Flags come from the unsigned addition of the lower bytes.
+                unsigned := int(byte(e))
+                expectedFlags := flagsOf(false, false, low & 0x0F + unsigned & 0x0F > 0x0F, low + unsigned > 0xFF)
+
+                result, f := AddOffset(uint16(a), int8(e))
+
+                if result != expected || f != expectedFlags {
+                    t.Fatalf("ADD 0x%04X, %d: got 0x%04X F 0x%02X, expected 0x%04X F 0x%02X", a, e, result, f, expected, expectedFlags)
+                }
+            }
+        }
+    }
+}
+
+// toBCD returns the BCD encoding of a number between 0 and 99.
+func toBCD(n int) byte {
+    return byte(n / 10 << 4 | n % 10)
+}
+
+// TestDAAAfterBCDArithmetic adds and subtracts all pairs of BCD numbers, with and without
+// carry, and checks that DAA adjusts the result to the BCD result and carry.
+func TestDAAAfterBCDArithmetic(t *testing.T) {
+
+    for x := 0; x < 100; x++ {
+        for y := 0; y < 100; y++ {
+            for carry := 0; carry < 2; carry++ {
+
+                flags := byte(carry) << 4
+
+                // Addition.
+                sum, f := Adc(toBCD(x), toBCD(y), flags)
+                result, f := DAA(sum, f)
+
+                expected := (x + y + carry) % 100
+                expectedFlags := flagsOf(expected == 0, false, false, x + y + carry > 99)
+                if result != toBCD(expected) || f != expectedFlags {
+                    t.Fatalf("%d + %d + %d: DAA got 0x%02X F 0x%02X, expected 0x%02X F 0x%02X",
+                        x, y, carry, result, f, toBCD(expected), expectedFlags)
+                }
+
+                // Subtraction.
+                difference, f := Sbc(toBCD(x), toBCD(y), flags)
+                result, f = DAA(difference, f)
+
+                expected = (x - y - carry + 100) % 100
+                expectedFlags = flagsOf(expected == 0, true, false, x - y - carry < 0)
+                if result != toBCD(expected) || f != expectedFlags {
+                    t.Fatalf("%d - %d - %d: DAA got 0x%02X F 0x%02X, expected 0x%02X F 0x%02X",
+                        x, y, carry, result, f, toBCD(expected), expectedFlags)
+                }
+            }
+        }
+    }
+}
+
+// TestDAAOnAllInputs checks the flags DAA sets for every A and F, BCD or not.
+func TestDAAOnAllInputs(t *testing.T) {
+
+    for a := 0; a < 256; a++ {
+        for _, flags := range allFlags {
+
+            result, f := DAA(byte(a), flags)
+
+            if f & 0x0F != 0 || f & H != 0 {
+                t.Fatalf("DAA 0x%02X with F 0x%02X: H and the lower bits should be 0, got F 0x%02X", a, flags, f)
+            }
+            if f & N != flags & N {
+                t.Fatalf("DAA 0x%02X with F 0x%02X: N should be unchanged, got F 0x%02X", a, flags, f)
+            }
+            if (f & Z != 0) != (result == 0) {
+                t.Fatalf("DAA 0x%02X with F 0x%02X: Z should match result 0x%02X, got F 0x%02X", a, flags, result, f)
+            }
+
+            // A carry is never cleared, and only set by additions.
+            if flags & C != 0 && f & C == 0 || flags & N != 0 && f & C != flags & C {
+                t.Fatalf("DAA 0x%02X with F 0x%02X: wrong carry, got F 0x%02X", a, flags, f)
+            }
+        }
+    }
+}
+
+func TestCarryAndComplementOperations(t *testing.T) {
+
+    for a := 0; a < 256; a++ {
+        for _, flags := range allFlags {
+
+            result, f := CPL(byte(a), flags)
+            if result != ^byte(a) || f != flags | N | H {
+                t.Fatalf("CPL 0x%02X with F 0x%02X: got 0x%02X F 0x%02X", a, flags, result, f)
+            }
+        }
+    }
+
+    for _, flags := range allFlags {
+
+        if f := SCF(flags); f != flags & Z | C {
+            t.Fatalf("SCF with F 0x%02X: got F 0x%02X", flags, f)
+        }
+        if f := CCF(flags); f != flags & Z | (flags ^ C) & C {
+            t.Fatalf("CCF with F 0x%02X: got F 0x%02X", flags, f)
+        }
+    }
+}
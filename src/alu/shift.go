@@ -0,0 +1,102 @@
+package alu
+
+// Rotates and shifts move one bit out of the value into the carry flag.
+
+// shifted returns the flags of the CB rotates and shifts.
+// Flags: Z = star, N = 0, H = 0, C = bit shifted out.
+func shifted(result byte, out byte) byte {
+    return zero(result) | out << 4
+}
+
+// RLC rotates value left, bit 7 goes into bit 0 and into C.
+// Flags: Z = star, N = 0, H = 0, C = star.
+func RLC(value byte) (byte, byte) {
+    result := value << 1 | value >> 7
+    return result, shifted(result, value >> 7)
+}
+
+// RRC rotates value right, bit 0 goes into bit 7 and into C.
+// Flags: Z = star, N = 0, H = 0, C = star.
+func RRC(value byte) (byte, byte) {
+    result := value >> 1 | value << 7
+    return result, shifted(result, value & 0x01)
+}
+
+// RL rotates value left through the carry: C goes into bit 0, bit 7 into C.
+// Flags: Z = star, N = 0, H = 0, C = star.
+func RL(value, flags byte) (byte, byte) {
+    result := value << 1 | carry(flags)
+    return result, shifted(result, value >> 7)
+}
+
+// RR rotates value right through the carry: C goes into bit 7, bit 0 into C.
+// Flags: Z = star, N = 0, H = 0, C = star.
+func RR(value, flags byte) (byte, byte) {
+    result := value >> 1 | carry(flags) << 7
+    return result, shifted(result, value & 0x01)
+}
+
+// SLA shifts value left, bit 0 is reset.
+// Flags: Z = star, N = 0, H = 0, C = star.
+func SLA(value byte) (byte, byte) {
+    result := value << 1
+    return result, shifted(result, value >> 7)
+}
+
+// SRA shifts value right, bit 7 is left unchanged.
+// Flags: Z = star, N = 0, H = 0, C = star.
+func SRA(value byte) (byte, byte) {
+    result := value >> 1 | value & 0x80
+    return result, shifted(result, value & 0x01)
+}
+
+// SRL shifts value right, bit 7 is reset.
+// Flags: Z = star, N = 0, H = 0, C = star.
+func SRL(value byte) (byte, byte) {
+    result := value >> 1
+    return result, shifted(result, value & 0x01)
+}
+
+// SWAP swaps the upper and the lower nibble of value.
+// Flags: Z = star, N = 0, H = 0, C = 0.
+func SWAP(value byte) (byte, byte) {
+    result := value << 4 | value >> 4
+    return result, zero(result)
+}
+
+// The accumulator rotates RLCA, RRCA, RLA and RRA work as their CB counterparts on A,
+// except that Z is always cleared.
+
+// RLCA rotates a left, bit 7 goes into bit 0 and into C.
+// Flags: Z = 0, N = 0, H = 0, C = star.
+func RLCA(a byte) (byte, byte) {
+    result, f := RLC(a)
+    return result, f &^ Z
+}
+
+// RRCA rotates a right, bit 0 goes into bit 7 and into C.
+// Flags: Z = 0, N = 0, H = 0, C = star.
+func RRCA(a byte) (byte, byte) {
+    result, f := RRC(a)
+    return result, f &^ Z
+}
+
+// RLA rotates a left through the carry.
+// Flags: Z = 0, N = 0, H = 0, C = star.
+func RLA(a, flags byte) (byte, byte) {
+    result, f := RL(a, flags)
+    return result, f &^ Z
+}
+
+// RRA rotates a right through the carry.
+// Flags: Z = 0, N = 0, H = 0, C = star.
+func RRA(a, flags byte) (byte, byte) {
+    result, f := RR(a, flags)
+    return result, f &^ Z
+}
+
+// Bit tests bit n of value: Z is set if it is 0.
+// Flags: Z = star, N = 0, H = 1, C = unchanged.
+func Bit(n, value, flags byte) byte {
+    return flags & C | zero(value & (1 << n)) | H
+}
@@ -0,0 +1,125 @@
+package alu
+
+import (
+    "math/bits"
+    "testing"
+)
+
+// shiftOperation is a rotate or shift, with its reference implementation on the
+// 9-bit value carry:value.
+type shiftOperation struct {
+    name        string
+    operation   func(value, flags byte) (byte, byte)
+    reference   func(value, carry uint16) (result uint16, carryOut bool)
+    accumulator bool
+}
+
+var shiftOperations = []shiftOperation{
+    {
+        "RLC",
+        func(value, flags byte) (byte, byte) { return RLC(value) },
+        func(value, carry uint16) (uint16, bool) { return uint16(bits.RotateLeft8(uint8(value), 1)), value & 0x80 != 0 },
+        false,
+    },
+    {
+        "RRC",
+        func(value, flags byte) (byte, byte) { return RRC(value) },
+        func(value, carry uint16) (uint16, bool) { return uint16(bits.RotateLeft8(uint8(value), -1)), value & 0x01 != 0 },
+        false,
+    },
+    {
+        "RL", RL,
+        func(value, carry uint16) (uint16, bool) { nine := value << 1 | carry; return nine & 0xFF, nine & 0x100 != 0 },
+        false,
+    },
+    {
+        "RR", RR,
+        func(value, carry uint16) (uint16, bool) { nine := carry << 8 | value; return nine >> 1, nine & 0x01 != 0 },
+        false,
+    },
+    {
+        "SLA",
+        func(value, flags byte) (byte, byte) { return SLA(value) },
+        func(value, carry uint16) (uint16, bool) { return value * 2 & 0xFF, value >= 0x80 },
+        false,
+    },
+    {
+        "SRA",
+        func(value, flags byte) (byte, byte) { return SRA(value) },
+        func(value, carry uint16) (uint16, bool) { return uint16(uint8(int8(value) >> 1)), value % 2 == 1 },
+        false,
+    },
+    {
+        "SRL",
+        func(value, flags byte) (byte, byte) { return SRL(value) },
+        func(value, carry uint16) (uint16, bool) { return value / 2, value % 2 == 1 },
+        false,
+    },
+    {
+        "SWAP",
+        func(value, flags byte) (byte, byte) { return SWAP(value) },
+        func(value, carry uint16) (uint16, bool) { return value % 16 * 16 + value / 16, false },
+        false,
+    },
+    {
+        "RLCA",
+        func(value, flags byte) (byte, byte) { return RLCA(value) },
+        func(value, carry uint16) (uint16, bool) { return uint16(bits.RotateLeft8(uint8(value), 1)), value & 0x80 != 0 },
+        true,
+    },
+    {
+        "RRCA",
+        func(value, flags byte) (byte, byte) { return RRCA(value) },
+        func(value, carry uint16) (uint16, bool) { return uint16(bits.RotateLeft8(uint8(value), -1)), value & 0x01 != 0 },
+        true,
+    },
+    {
+        "RLA", RLA,
+        func(value, carry uint16) (uint16, bool) { nine := value << 1 | carry; return nine & 0xFF, nine & 0x100 != 0 },
+        true,
+    },
+    {
+        "RRA", RRA,
+        func(value, carry uint16) (uint16, bool) { nine := carry << 8 | value; return nine >> 1, nine & 0x01 != 0 },
+        true,
+    },
+}
+
+func TestShiftOperationsOnAllInputs(t *testing.T) {
+
+    for _, op := range shiftOperations {
+        for value := 0; value < 256; value++ {
+            for _, flags := range allFlags {
+
+                // Given
+                expected, carryOut := op.reference(uint16(value), uint16(flags >> 4 & 1))
+                expectedFlags := flagsOf(expected == 0 && !op.accumulator, false, false, carryOut)
+
+                // When
+                result, f := op.operation(byte(value), flags)
+
+                // Then
+                if uint16(result) != expected || f != expectedFlags {
+                    t.Fatalf("%s 0x%02X with F 0x%02X: got 0x%02X F 0x%02X, expected 0x%02X F 0x%02X",
+                        op.name, value, flags, result, f, expected, expectedFlags)
+                }
+            }
+        }
+    }
+}
+
+func TestBitOnAllInputs(t *testing.T) {
+
+    for n := byte(0); n < 8; n++ {
+        for value := 0; value < 256; value++ {
+            for _, flags := range allFlags {
+
+                expectedFlags := flagsOf(value >> n & 1 == 0, false, true, flags & C != 0)
+
+                if f := Bit(n, byte(value), flags); f != expectedFlags {
+                    t.Fatalf("BIT %d, 0x%02X with F 0x%02X: got F 0x%02X, expected F 0x%02X", n, value, flags, f, expectedFlags)
+                }
+            }
+        }
+    }
+}
@@ -15,10 +15,13 @@ func TestADDHL_BC(t *testing.T) {
     cpu.Registers.L = 0x20
     cpu.Registers.B = 0x25
     cpu.Registers.C = 0x2F
-    cpu.Memory.RAM[0x0100] = instructions.ADDHL_BC
+    ram(cpu)[0x0100] = instructions.ADDHL_BC
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     // Then
     if cyclesUsed != expectedCycles {
@@ -52,10 +55,13 @@ func TestADDHL_BCSetsHandCFlags(t *testing.T) {
     cpu.Registers.L = 0x20
     cpu.Registers.B = 0xEF
     cpu.Registers.C = 0x2F
-    cpu.Memory.RAM[0x0100] = instructions.ADDHL_BC
+    ram(cpu)[0x0100] = instructions.ADDHL_BC
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     // Then
     if cyclesUsed != expectedCycles {
@@ -87,10 +93,13 @@ func TestINC_BC(t *testing.T) {
     // When
     cpu.Registers.B = 0x25
     cpu.Registers.C = 0x26
-    cpu.Memory.RAM[0x0100] = instructions.INC_BC
+    ram(cpu)[0x0100] = instructions.INC_BC
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     // Then
     if cyclesUsed != expectedCycles {
@@ -110,10 +119,13 @@ func TestDEC_BC(t *testing.T) {
     // When
     cpu.Registers.B = 0x25
     cpu.Registers.C = 0x26
-    cpu.Memory.RAM[0x0100] = instructions.DEC_BC
+    ram(cpu)[0x0100] = instructions.DEC_BC
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     // Then
     if cyclesUsed != expectedCycles {
@@ -133,10 +145,13 @@ func TestINC_DE(t *testing.T) {
     // When
     cpu.Registers.D = 0x25
     cpu.Registers.E = 0x26
-    cpu.Memory.RAM[0x0100] = instructions.INC_DE
+    ram(cpu)[0x0100] = instructions.INC_DE
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     // Then
     if cyclesUsed != expectedCycles {
@@ -156,10 +171,13 @@ func TestDEC_DE(t *testing.T) {
     // When
     cpu.Registers.D = 0x25
     cpu.Registers.E = 0x26
-    cpu.Memory.RAM[0x0100] = instructions.DEC_DE
+    ram(cpu)[0x0100] = instructions.DEC_DE
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     // Then
     if cyclesUsed != expectedCycles {
@@ -181,10 +199,13 @@ func TestADDHL_DESetsHandCFlags(t *testing.T) {
     cpu.Registers.L = 0x20
     cpu.Registers.D = 0xEF
     cpu.Registers.E = 0x2F
-    cpu.Memory.RAM[0x0100] = instructions.ADDHL_DE
+    ram(cpu)[0x0100] = instructions.ADDHL_DE
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     // Then
     if cyclesUsed != expectedCycles {
@@ -215,11 +236,14 @@ func TestADDSP_e(t *testing.T) {
 
     // When
     // SP = 0xFFFE
-    cpu.Memory.RAM[0x0100] = instructions.ADDSP_e
-    cpu.Memory.RAM[0x0101] = 0xFB // (-5)
+    ram(cpu)[0x0100] = instructions.ADDSP_e
+    ram(cpu)[0x0101] = 0xFB // (-5)
 
     expectedCycles := 4
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     // Then
     if cyclesUsed != expectedCycles {
@@ -246,3 +270,27 @@ func TestADDSP_e(t *testing.T) {
         t.Error("HL should be 0xFFF9. Instead got: ", cpu.HL())
     }
 }
+
+func TestADDHLLeavesZeroFlagUnchanged(t *testing.T) {
+
+    // Given
+    cpu := InitSM83()
+    cpu.Registers.F = 0b11000000
+    ram(cpu)[0x0100] = instructions.ADDHL_HL
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.F != 0b10000000 {
+        t.Error("Only Z should be set. Instead got: ", cpu.Registers.F)
+    }
+}
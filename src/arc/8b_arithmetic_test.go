@@ -12,10 +12,13 @@ func TestINC_B(t *testing.T) {
 
     // When
     cpu.Registers.B = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.INC_B
+    ram(cpu)[0x0100] = instructions.INC_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -33,10 +36,13 @@ func TestINC_BSetsZandHFlags(t *testing.T) {
 
     // When
     cpu.Registers.B = 0xFF
-    cpu.Memory.RAM[0x0100] = instructions.INC_B
+    ram(cpu)[0x0100] = instructions.INC_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -58,10 +64,13 @@ func TestDEC_B(t *testing.T) {
 
     // When
     cpu.Registers.B = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.DEC_B
+    ram(cpu)[0x0100] = instructions.DEC_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -79,10 +88,13 @@ func TestDEC_BSetsZandHFlags(t *testing.T) {
 
     // When
     cpu.Registers.B = 0xF0
-    cpu.Memory.RAM[0x0100] = instructions.DEC_B
+    ram(cpu)[0x0100] = instructions.DEC_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -104,10 +116,13 @@ func TestINC_C(t *testing.T) {
 
     // When
     cpu.Registers.C = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.INC_C
+    ram(cpu)[0x0100] = instructions.INC_C
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -125,10 +140,13 @@ func TestINC_CSetsZandHFlags(t *testing.T) {
 
     // When
     cpu.Registers.C = 0xFF
-    cpu.Memory.RAM[0x0100] = instructions.INC_C
+    ram(cpu)[0x0100] = instructions.INC_C
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -150,10 +168,13 @@ func TestDEC_C(t *testing.T) {
 
     // When
     cpu.Registers.C = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.DEC_C
+    ram(cpu)[0x0100] = instructions.DEC_C
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -171,10 +192,13 @@ func TestDEC_CSetsZandHFlags(t *testing.T) {
 
     // When
     cpu.Registers.C = 0xF0
-    cpu.Memory.RAM[0x0100] = instructions.DEC_C
+    ram(cpu)[0x0100] = instructions.DEC_C
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -196,10 +220,13 @@ func TestDEC_E(t *testing.T) {
 
     // When
     cpu.Registers.E = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.DEC_E
+    ram(cpu)[0x0100] = instructions.DEC_E
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -217,10 +244,13 @@ func TestDEC_ESetsZandHFlags(t *testing.T) {
 
     // When
     cpu.Registers.E = 0xF0
-    cpu.Memory.RAM[0x0100] = instructions.DEC_E
+    ram(cpu)[0x0100] = instructions.DEC_E
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -242,10 +272,13 @@ func TestINC_E(t *testing.T) {
 
     // When
     cpu.Registers.E = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.INC_E
+    ram(cpu)[0x0100] = instructions.INC_E
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -263,10 +296,13 @@ func TestINC_ESetsZandHFlags(t *testing.T) {
 
     // When
     cpu.Registers.E = 0xFF
-    cpu.Memory.RAM[0x0100] = instructions.INC_E
+    ram(cpu)[0x0100] = instructions.INC_E
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -288,10 +324,13 @@ func TestINC_H(t *testing.T) {
 
     // When
     cpu.Registers.H = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.INC_H
+    ram(cpu)[0x0100] = instructions.INC_H
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -309,10 +348,13 @@ func TestINC_HSetsZandHFlags(t *testing.T) {
 
     // When
     cpu.Registers.H = 0xFF
-    cpu.Memory.RAM[0x0100] = instructions.INC_H
+    ram(cpu)[0x0100] = instructions.INC_H
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -334,10 +376,13 @@ func TestDEC_H(t *testing.T) {
 
     // When
     cpu.Registers.H = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.DEC_H
+    ram(cpu)[0x0100] = instructions.DEC_H
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -355,10 +400,13 @@ func TestDEC_HSetsZandHFlags(t *testing.T) {
 
     // When
     cpu.Registers.H = 0xF0
-    cpu.Memory.RAM[0x0100] = instructions.DEC_H
+    ram(cpu)[0x0100] = instructions.DEC_H
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -380,10 +428,13 @@ func TestINC_A(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.INC_A
+    ram(cpu)[0x0100] = instructions.INC_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -401,10 +452,13 @@ func TestINC_ASetsZandHFlags(t *testing.T) {
 
     // When
     cpu.Registers.A = 0xFF
-    cpu.Memory.RAM[0x0100] = instructions.INC_A
+    ram(cpu)[0x0100] = instructions.INC_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -426,10 +480,13 @@ func TestDEC_A(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.DEC_A
+    ram(cpu)[0x0100] = instructions.DEC_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -447,10 +504,13 @@ func TestDEC_ASetsZandHFlags(t *testing.T) {
 
     // When
     cpu.Registers.A = 0xF0
-    cpu.Memory.RAM[0x0100] = instructions.DEC_A
+    ram(cpu)[0x0100] = instructions.DEC_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -471,13 +531,16 @@ func TestDAAAdjustsAferINC_A(t *testing.T) {
     cpu := InitSM83()
 
     // When
-    cpu.Memory.RAM[0x0100] = instructions.LDA_d8
-    cpu.Memory.RAM[0x0101] = 0x09
-    cpu.Memory.RAM[0x0102] = instructions.INC_A
-    cpu.Memory.RAM[0x0103] = instructions.DAA
+    ram(cpu)[0x0100] = instructions.LDA_d8
+    ram(cpu)[0x0101] = 0x09
+    ram(cpu)[0x0102] = instructions.INC_A
+    ram(cpu)[0x0103] = instructions.DAA
 
     expectedCycles := 2 + 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -494,13 +557,16 @@ func TestDAAAdjustsAferDEC_A(t *testing.T) {
     cpu := InitSM83()
 
     // When
-    cpu.Memory.RAM[0x0100] = instructions.LDA_d8
-    cpu.Memory.RAM[0x0101] = 0x20
-    cpu.Memory.RAM[0x0102] = instructions.DEC_A
-    cpu.Memory.RAM[0x0103] = instructions.DAA
+    ram(cpu)[0x0100] = instructions.LDA_d8
+    ram(cpu)[0x0101] = 0x20
+    ram(cpu)[0x0102] = instructions.DEC_A
+    ram(cpu)[0x0103] = instructions.DAA
 
     expectedCycles := 2 + 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -519,10 +585,13 @@ func TestCPL(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x3F
-    cpu.Memory.RAM[0x0100] = instructions.CPL
+    ram(cpu)[0x0100] = instructions.CPL
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -551,18 +620,21 @@ func TestINC_indHL(t *testing.T) {
     // When
     cpu.Registers.H = 0x30
     cpu.Registers.L = 0x59
-    cpu.Memory.RAM[0x0100] = instructions.INC_indHL
-    cpu.Memory.RAM[0x3059] = 0x69
+    ram(cpu)[0x0100] = instructions.INC_indHL
+    ram(cpu)[0x3059] = 0x69
 
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[0x3059] != 0x6A {
-        t.Error("Data at 0x3059 should be 0x6A. Instead got: ", cpu.Memory.RAM[0x3059])
+    if ram(cpu)[0x3059] != 0x6A {
+        t.Error("Data at 0x3059 should be 0x6A. Instead got: ", ram(cpu)[0x3059])
     }
 }
 
@@ -574,18 +646,21 @@ func TestINC_indHLSetsHAndZFlags(t *testing.T) {
     // When
     cpu.Registers.H = 0x30
     cpu.Registers.L = 0x59
-    cpu.Memory.RAM[0x0100] = instructions.INC_indHL
-    cpu.Memory.RAM[0x3059] = 0xFF
+    ram(cpu)[0x0100] = instructions.INC_indHL
+    ram(cpu)[0x3059] = 0xFF
 
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[0x3059] != 0x00 {
-        t.Error("Data at 0x3059 should be 0x00. Instead got: ", cpu.Memory.RAM[0x3059])
+    if ram(cpu)[0x3059] != 0x00 {
+        t.Error("Data at 0x3059 should be 0x00. Instead got: ", ram(cpu)[0x3059])
     }
 
     if (cpu.Registers.F & (1 << 7)) == 0 {
@@ -609,18 +684,21 @@ func TestDEC_indHL(t *testing.T) {
     // When
     cpu.Registers.H = 0x30
     cpu.Registers.L = 0x59
-    cpu.Memory.RAM[0x0100] = instructions.DEC_indHL
-    cpu.Memory.RAM[0x3059] = 0x69
+    ram(cpu)[0x0100] = instructions.DEC_indHL
+    ram(cpu)[0x3059] = 0x69
 
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[0x3059] != 0x68 {
-        t.Error("Data at 0x3059 should be 0x68. Instead got: ", cpu.Memory.RAM[0x3059])
+    if ram(cpu)[0x3059] != 0x68 {
+        t.Error("Data at 0x3059 should be 0x68. Instead got: ", ram(cpu)[0x3059])
     }
 }
 
@@ -632,11 +710,14 @@ func TestDEC_indHLSetsZAndNFlags(t *testing.T) {
     // When
     cpu.Registers.H = 0x30
     cpu.Registers.L = 0x59
-    cpu.Memory.RAM[0x0100] = instructions.DEC_indHL
-    cpu.Memory.RAM[0x3059] = 0x01
+    ram(cpu)[0x0100] = instructions.DEC_indHL
+    ram(cpu)[0x3059] = 0x01
 
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -661,13 +742,14 @@ func TestSCF(t *testing.T) {
     cpu := InitSM83()
 
     // When
-    cpu.SetNflag()
-    cpu.SetHflag()
-    cpu.ClearCflag()
-    cpu.Memory.RAM[0x0100] = instructions.SCF
+    cpu.Registers.F = 0xE0 // Z, N and H set, C clear.
+    ram(cpu)[0x0100] = instructions.SCF
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -692,13 +774,14 @@ func TestCCF(t *testing.T) {
     cpu := InitSM83()
 
     // When
-    cpu.SetNflag()
-    cpu.SetHflag()
-    cpu.ClearCflag()
-    cpu.Memory.RAM[0x0100] = instructions.SCF
+    cpu.Registers.F = 0xE0 // Z, N and H set, C clear.
+    ram(cpu)[0x0100] = instructions.SCF
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -725,10 +808,13 @@ func TestADDA_B(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.ADD_B
+    ram(cpu)[0x0100] = instructions.ADD_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -751,10 +837,13 @@ func TestADDA_C(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.C = 0xCB
-    cpu.Memory.RAM[0x0100] = instructions.ADD_C
+    ram(cpu)[0x0100] = instructions.ADD_C
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -781,10 +870,13 @@ func TestADDA_D(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.D = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.ADD_D
+    ram(cpu)[0x0100] = instructions.ADD_D
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -807,10 +899,13 @@ func TestADDA_E(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.E = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.ADD_E
+    ram(cpu)[0x0100] = instructions.ADD_E
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -833,10 +928,13 @@ func TestADDA_H(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.ADD_H
+    ram(cpu)[0x0100] = instructions.ADD_H
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -859,10 +957,13 @@ func TestADDA_L(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.L = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.ADD_L
+    ram(cpu)[0x0100] = instructions.ADD_L
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -884,10 +985,13 @@ func TestADDA_A(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.ADD_A
+    ram(cpu)[0x0100] = instructions.ADD_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -911,11 +1015,14 @@ func TestADDA_HL(t *testing.T) {
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x90
     cpu.Registers.L = 0x08
-    cpu.Memory.RAM[0x0100] = instructions.ADD_indHL
-    cpu.Memory.RAM[0x9008] = 0x35
+    ram(cpu)[0x0100] = instructions.ADD_indHL
+    ram(cpu)[0x9008] = 0x35
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -938,12 +1045,15 @@ func TestADC_B(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0xCA
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.ADC_B
+    ram(cpu)[0x0101] = instructions.ADC_B
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -978,12 +1088,15 @@ func TestADC_C(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.C = 0xCA
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.ADC_C
+    ram(cpu)[0x0101] = instructions.ADC_C
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1018,12 +1131,15 @@ func TestADC_D(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.D = 0xCA
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.ADC_D
+    ram(cpu)[0x0101] = instructions.ADC_D
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1058,12 +1174,15 @@ func TestADC_E(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.E = 0xCA
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.ADC_E
+    ram(cpu)[0x0101] = instructions.ADC_E
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1098,12 +1217,15 @@ func TestADC_H(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0xCA
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.ADC_H
+    ram(cpu)[0x0101] = instructions.ADC_H
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1138,12 +1260,15 @@ func TestADC_L(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.L = 0xCA
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.ADC_L
+    ram(cpu)[0x0101] = instructions.ADC_L
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1177,12 +1302,15 @@ func TestADC_A(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x80
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.ADC_A
+    ram(cpu)[0x0101] = instructions.ADC_A
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1218,12 +1346,15 @@ func TestADC_HL(t *testing.T) {
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x90
     cpu.Registers.L = 0x08
-    cpu.Memory.RAM[0x0100] = instructions.CCF
-    cpu.Memory.RAM[0x0101] = instructions.ADC_indHL
-    cpu.Memory.RAM[0x9008] = 0x35
+    ram(cpu)[0x0100] = instructions.CCF
+    ram(cpu)[0x0101] = instructions.ADC_indHL
+    ram(cpu)[0x9008] = 0x35
 
     expectedCycles := 1 + 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1246,10 +1377,13 @@ func TestSUB_B(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.SUB_B
+    ram(cpu)[0x0100] = instructions.SUB_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1287,10 +1421,13 @@ func TestSUB_BSetsHandCflags(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0x47
-    cpu.Memory.RAM[0x0100] = instructions.SUB_B
+    ram(cpu)[0x0100] = instructions.SUB_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1325,10 +1462,13 @@ func TestSUB_C(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.C = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.SUB_C
+    ram(cpu)[0x0100] = instructions.SUB_C
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1363,10 +1503,13 @@ func TestSUB_D(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.D = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.SUB_D
+    ram(cpu)[0x0100] = instructions.SUB_D
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1401,10 +1544,13 @@ func TestSUB_E(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.E = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.SUB_E
+    ram(cpu)[0x0100] = instructions.SUB_E
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1439,10 +1585,13 @@ func TestSUB_H(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.SUB_H
+    ram(cpu)[0x0100] = instructions.SUB_H
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1477,10 +1626,13 @@ func TestSUB_L(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.L = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.SUB_L
+    ram(cpu)[0x0100] = instructions.SUB_L
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1514,10 +1666,13 @@ func TestSUB_A(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.SUB_A
+    ram(cpu)[0x0100] = instructions.SUB_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1553,11 +1708,14 @@ func TestSUB_indHL(t *testing.T) {
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x90
     cpu.Registers.L = 0x08
-    cpu.Memory.RAM[0x0100] = instructions.SUB_indHL
-    cpu.Memory.RAM[0x9008] = 0x35
+    ram(cpu)[0x0100] = instructions.SUB_indHL
+    ram(cpu)[0x9008] = 0x35
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1585,12 +1743,15 @@ func TestSBC_B(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.SBC_B
+    ram(cpu)[0x0101] = instructions.SBC_B
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1625,12 +1786,15 @@ func TestSBC_BSetsHandCflags(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0x46
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.SBC_B
+    ram(cpu)[0x0101] = instructions.SBC_B
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1665,12 +1829,15 @@ func TestSBC_C(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.C = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.SBC_C
+    ram(cpu)[0x0101] = instructions.SBC_C
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1705,12 +1872,15 @@ func TestSBC_D(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.D = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.SBC_D
+    ram(cpu)[0x0101] = instructions.SBC_D
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1745,12 +1915,15 @@ func TestSBC_E(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.E = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.SBC_E
+    ram(cpu)[0x0101] = instructions.SBC_E
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1785,10 +1958,13 @@ func TestSBC_A(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     // flag C is set
-    cpu.Memory.RAM[0x0100] = instructions.SBC_A
+    ram(cpu)[0x0100] = instructions.SBC_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1823,12 +1999,15 @@ func TestSBC_H(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.SBC_H
+    ram(cpu)[0x0101] = instructions.SBC_H
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1863,12 +2042,15 @@ func TestSBC_L(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.L = 0x34
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.SBC_L
+    ram(cpu)[0x0101] = instructions.SBC_L
 
     expectedCycles := 1 + 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1904,11 +2086,14 @@ func TestSBC_indHL(t *testing.T) {
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x90
     cpu.Registers.L = 0x08
-    cpu.Memory.RAM[0x0100] = instructions.SBC_indHL
-    cpu.Memory.RAM[0x9008] = 0x35
+    ram(cpu)[0x0100] = instructions.SBC_indHL
+    ram(cpu)[0x9008] = 0x35
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1935,10 +2120,13 @@ func TestAND_B(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.AND_B
+    ram(cpu)[0x0100] = instructions.AND_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1973,10 +2161,13 @@ func TestAND_C(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.C = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.AND_C
+    ram(cpu)[0x0100] = instructions.AND_C
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2011,10 +2202,13 @@ func TestAND_D(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.D = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.AND_D
+    ram(cpu)[0x0100] = instructions.AND_D
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2049,10 +2243,13 @@ func TestAND_E(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.E = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.AND_E
+    ram(cpu)[0x0100] = instructions.AND_E
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2087,10 +2284,13 @@ func TestAND_H(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.AND_H
+    ram(cpu)[0x0100] = instructions.AND_H
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2125,10 +2325,13 @@ func TestAND_L(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.L = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.AND_L
+    ram(cpu)[0x0100] = instructions.AND_L
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2162,10 +2365,13 @@ func TestAND_A(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.AND_A
+    ram(cpu)[0x0100] = instructions.AND_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2201,11 +2407,14 @@ func TestAND_indHL(t *testing.T) {
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x99
     cpu.Registers.L = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.AND_indHL
-    cpu.Memory.RAM[0x9913] = 0x13
+    ram(cpu)[0x0100] = instructions.AND_indHL
+    ram(cpu)[0x9913] = 0x13
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2239,10 +2448,13 @@ func TestAND_AWorksWithZeroValue(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x0
-    cpu.Memory.RAM[0x0100] = instructions.AND_A
+    ram(cpu)[0x0100] = instructions.AND_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2277,10 +2489,13 @@ func TestXOR_B(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.XOR_B
+    ram(cpu)[0x0100] = instructions.XOR_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2315,10 +2530,13 @@ func TestXOR_C(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.C = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.XOR_C
+    ram(cpu)[0x0100] = instructions.XOR_C
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2353,10 +2571,13 @@ func TestXOR_D(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.D = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.XOR_D
+    ram(cpu)[0x0100] = instructions.XOR_D
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2391,10 +2612,13 @@ func TestXOR_E(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.E = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.XOR_E
+    ram(cpu)[0x0100] = instructions.XOR_E
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2429,10 +2653,13 @@ func TestXOR_H(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.XOR_H
+    ram(cpu)[0x0100] = instructions.XOR_H
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2467,10 +2694,13 @@ func TestXOR_L(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.L = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.XOR_L
+    ram(cpu)[0x0100] = instructions.XOR_L
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2504,10 +2734,13 @@ func TestXOR_A(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.XOR_A
+    ram(cpu)[0x0100] = instructions.XOR_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2543,11 +2776,14 @@ func TestXOR_indHL(t *testing.T) {
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x99
     cpu.Registers.L = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.XOR_indHL
-    cpu.Memory.RAM[0x9913] = 0x13
+    ram(cpu)[0x0100] = instructions.XOR_indHL
+    ram(cpu)[0x9913] = 0x13
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2582,10 +2818,13 @@ func TestOR_B(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.OR_B
+    ram(cpu)[0x0100] = instructions.OR_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2620,10 +2859,13 @@ func TestOR_C(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.C = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.OR_C
+    ram(cpu)[0x0100] = instructions.OR_C
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2659,10 +2901,13 @@ func TestOR_D(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.D = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.OR_D
+    ram(cpu)[0x0100] = instructions.OR_D
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2698,10 +2943,13 @@ func TestOR_E(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.E = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.OR_E
+    ram(cpu)[0x0100] = instructions.OR_E
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2737,10 +2985,13 @@ func TestOR_H(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.OR_H
+    ram(cpu)[0x0100] = instructions.OR_H
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2775,10 +3026,13 @@ func TestOR_L(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.L = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.OR_L
+    ram(cpu)[0x0100] = instructions.OR_L
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2814,11 +3068,14 @@ func TestOR_indHL(t *testing.T) {
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x99
     cpu.Registers.L = 0x13
-    cpu.Memory.RAM[0x0100] = instructions.OR_indHL
-    cpu.Memory.RAM[0x9913] = 0x13
+    ram(cpu)[0x0100] = instructions.OR_indHL
+    ram(cpu)[0x9913] = 0x13
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2852,10 +3109,13 @@ func TestOR_A(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.OR_A
+    ram(cpu)[0x0100] = instructions.OR_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2890,10 +3150,13 @@ func TestCP_B(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.CP_A
+    ram(cpu)[0x0100] = instructions.CP_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2931,10 +3194,13 @@ func TestCP_BSetsHandCflags(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.B = 0x47
-    cpu.Memory.RAM[0x0100] = instructions.CP_B
+    ram(cpu)[0x0100] = instructions.CP_B
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2965,10 +3231,13 @@ func TestCP_C(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.C = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.CP_C
+    ram(cpu)[0x0100] = instructions.CP_C
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2999,10 +3268,13 @@ func TestCP_D(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.D = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.CP_D
+    ram(cpu)[0x0100] = instructions.CP_D
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3033,10 +3305,13 @@ func TestCP_E(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.E = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.CP_E
+    ram(cpu)[0x0100] = instructions.CP_E
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3067,10 +3342,13 @@ func TestCP_H(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.CP_H
+    ram(cpu)[0x0100] = instructions.CP_H
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3101,10 +3379,13 @@ func TestCP_L(t *testing.T) {
     // When
     cpu.Registers.A = 0x35
     cpu.Registers.L = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.CP_L
+    ram(cpu)[0x0100] = instructions.CP_L
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3134,10 +3415,13 @@ func TestCP_A(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.CP_A
+    ram(cpu)[0x0100] = instructions.CP_A
 
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3169,11 +3453,14 @@ func TestCP_indHL(t *testing.T) {
     cpu.Registers.A = 0x35
     cpu.Registers.H = 0x90
     cpu.Registers.L = 0x08
-    cpu.Memory.RAM[0x0100] = instructions.CP_indHL
-    cpu.Memory.RAM[0x9008] = 0x35
+    ram(cpu)[0x0100] = instructions.CP_indHL
+    ram(cpu)[0x9008] = 0x35
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3195,11 +3482,14 @@ func TestADD_d8(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.ADD_d8
-    cpu.Memory.RAM[0x0101] = 0x35
+    ram(cpu)[0x0100] = instructions.ADD_d8
+    ram(cpu)[0x0101] = 0x35
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3221,13 +3511,16 @@ func TestADC_d8(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.ADC_d8
-    cpu.Memory.RAM[0x0102] = 0xCA
+    ram(cpu)[0x0101] = instructions.ADC_d8
+    ram(cpu)[0x0102] = 0xCA
 
     expectedCycles := 1 + 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3261,11 +3554,14 @@ func TestSUB_d8(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.SUB_d8
-    cpu.Memory.RAM[0x0101] = 0x35
+    ram(cpu)[0x0100] = instructions.SUB_d8
+    ram(cpu)[0x0101] = 0x35
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3299,13 +3595,16 @@ func TestSBC_d8(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.CCF
+    ram(cpu)[0x0100] = instructions.CCF
     // flag C is set
-    cpu.Memory.RAM[0x0101] = instructions.SBC_d8
-    cpu.Memory.RAM[0x0102] = 0x34
+    ram(cpu)[0x0101] = instructions.SBC_d8
+    ram(cpu)[0x0102] = 0x34
 
     expectedCycles := 1 + 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3339,11 +3638,14 @@ func TestAND_d8(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.AND_d8
-    cpu.Memory.RAM[0x0101] = 0x13
+    ram(cpu)[0x0100] = instructions.AND_d8
+    ram(cpu)[0x0101] = 0x13
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3377,11 +3679,14 @@ func TestXOR_d8(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.XOR_d8
-    cpu.Memory.RAM[0x0101] = 0x13
+    ram(cpu)[0x0100] = instructions.XOR_d8
+    ram(cpu)[0x0101] = 0x13
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3415,11 +3720,14 @@ func TestOR_d8(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.OR_d8
-    cpu.Memory.RAM[0x0101] = 0x13
+    ram(cpu)[0x0100] = instructions.OR_d8
+    ram(cpu)[0x0101] = 0x13
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3453,11 +3761,14 @@ func TestCP_d8(t *testing.T) {
 
     // When
     cpu.Registers.A = 0x35
-    cpu.Memory.RAM[0x0100] = instructions.CP_d8
-    cpu.Memory.RAM[0x0101] = 0x35
+    ram(cpu)[0x0100] = instructions.CP_d8
+    ram(cpu)[0x0101] = 0x35
 
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -3483,3 +3794,53 @@ func TestCP_d8(t *testing.T) {
         t.Error("A register should be 0x35. Instead got: ", cpu.Registers.A)
     }
 }
+
+func TestDAASetsZeroAndCarryAfterADD(t *testing.T) {
+
+    // Given: 0x55 + 0x45 = 0x100 in BCD.
+    cpu := InitSM83()
+    ram(cpu)[0x0100] = instructions.LDA_d8
+    ram(cpu)[0x0101] = 0x55
+    ram(cpu)[0x0102] = instructions.ADD_d8
+    ram(cpu)[0x0103] = 0x45
+    ram(cpu)[0x0104] = instructions.DAA
+
+    // When
+    expectedCycles := 2 + 2 + 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.A != 0x00 {
+        t.Error("A register should be 0x00. Instead got: ", cpu.Registers.A)
+    }
+
+    if cpu.Registers.F != 0b10010000 {
+        t.Error("Z and C should be set, N and H cleared. Instead got: ", cpu.Registers.F)
+    }
+}
+
+func TestINCClearsHalfCarry(t *testing.T) {
+
+    // Given
+    cpu := InitSM83()
+    cpu.Registers.F = 0b00110000
+    ram(cpu)[0x0100] = instructions.INC_B
+
+    // When
+    _, err := cpu.Execute(1)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then: C is left unchanged.
+    if cpu.Registers.F != 0b00010000 {
+        t.Error("Only C should be set. Instead got: ", cpu.Registers.F)
+    }
+}
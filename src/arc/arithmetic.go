@@ -0,0 +1,115 @@
+package arc
+
+import "cgbemu/src/alu"
+
+// The arithmetic and logic instructions compute their result and flags with the alu package.
+
+// Add8 adds value to A.
+// Sets following flags: Z = star, N = 0, H = star, C = star
+func (cpu *CPU) Add8(value byte) {
+    cpu.Registers.A, cpu.Registers.F = alu.Add(cpu.Registers.A, value)
+}
+
+// AddWithCarry8 adds value and the carry flag to A.
+// Sets following flags: Z = star, N = 0, H = star, C = star
+func (cpu *CPU) AddWithCarry8(value byte) {
+    cpu.Registers.A, cpu.Registers.F = alu.Adc(cpu.Registers.A, value, cpu.Registers.F)
+}
+
+// Sub8 subtracts value from A.
+// Sets following flags: Z = star, N = 1, H = star, C = star
+func (cpu *CPU) Sub8(value byte) {
+    cpu.Registers.A, cpu.Registers.F = alu.Sub(cpu.Registers.A, value)
+}
+
+// SubWithCarry8 subtracts value and the carry flag from A.
+// Sets following flags: Z = star, N = 1, H = star, C = star
+func (cpu *CPU) SubWithCarry8(value byte) {
+    cpu.Registers.A, cpu.Registers.F = alu.Sbc(cpu.Registers.A, value, cpu.Registers.F)
+}
+
+// And8 stores A & value into A.
+// Sets following flags: Z = star, N = 0, H = 1, C = 0
+func (cpu *CPU) And8(value byte) {
+    cpu.Registers.A, cpu.Registers.F = alu.And(cpu.Registers.A, value)
+}
+
+// Xor8 stores A ^ value into A.
+// Sets following flags: Z = star, N = 0, H = 0, C = 0
+func (cpu *CPU) Xor8(value byte) {
+    cpu.Registers.A, cpu.Registers.F = alu.Xor(cpu.Registers.A, value)
+}
+
+// Or8 stores A | value into A.
+// Sets following flags: Z = star, N = 0, H = 0, C = 0
+func (cpu *CPU) Or8(value byte) {
+    cpu.Registers.A, cpu.Registers.F = alu.Or(cpu.Registers.A, value)
+}
+
+// Compare8 subtracts value from A and only updates the flags, A is left untouched.
+// Sets following flags: Z = star, N = 1, H = star, C = star
+func (cpu *CPU) Compare8(value byte) {
+    cpu.Registers.F = alu.Cp(cpu.Registers.A, value)
+}
+
+// Increment8 returns value + 1.
+// Sets following flags: Z = star, N = 0, H = star, C = unchanged
+func (cpu *CPU) Increment8(value byte) byte {
+    result, flags := alu.Inc(value, cpu.Registers.F)
+    cpu.Registers.F = flags
+    return result
+}
+
+// Decrement8 returns value - 1.
+// Sets following flags: Z = star, N = 1, H = star, C = unchanged
+func (cpu *CPU) Decrement8(value byte) byte {
+    result, flags := alu.Dec(value, cpu.Registers.F)
+    cpu.Registers.F = flags
+    return result
+}
+
+// AddHL adds value to HL.
+// Sets following flags: Z = unchanged, N = 0, H = star, C = star
+// It consumes 1 internal machine cycle.
+func (cpu *CPU) AddHL(cycles *int, value uint16) {
+
+    result, flags := alu.AddWord(cpu.HL(), value, cpu.Registers.F)
+    cpu.Registers.F = flags
+
+    cpu.Registers.H = byte(result >> 8)
+    cpu.Registers.L = byte(result & 0xFF)
+    cpu.tick(cycles)
+}
+
+// AddSPOffset executes ADD SP, e: the signed 8-bit operand e is added to SP.
+// Sets following flags: Z = 0, N = 0, H = star, C = star
+// Cycles: 4 machine cycles. opcode, R(e), internal, internal.
+func (cpu *CPU) AddSPOffset(cycles *int) {
+
+    e := int8(cpu.FetchByte(cycles))
+    cpu.Registers.SP, cpu.Registers.F = alu.AddOffset(cpu.Registers.SP, e)
+
+    cpu.tick(cycles)
+    cpu.tick(cycles)
+}
+
+// DAA adjusts A to BCD after an addition or a subtraction of two BCD numbers, see alu.DAA.
+// Sets following flags: Z = star, N = unchanged, H = 0, C = star
+func (cpu *CPU) DAA() {
+    cpu.Registers.A, cpu.Registers.F = alu.DAA(cpu.Registers.A, cpu.Registers.F)
+}
+
+// CPL flips all the bits in A, and sets the N and H flags.
+func (cpu *CPU) CPL() {
+    cpu.Registers.A, cpu.Registers.F = alu.CPL(cpu.Registers.A, cpu.Registers.F)
+}
+
+// SCF sets the carry flag, and clears the N and H flags.
+func (cpu *CPU) SCF() {
+    cpu.Registers.F = alu.SCF(cpu.Registers.F)
+}
+
+// CCF flips the carry flag, and clears the N and H flags.
+func (cpu *CPU) CCF() {
+    cpu.Registers.F = alu.CCF(cpu.Registers.F)
+}
@@ -0,0 +1,95 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"testing"
+)
+
+// Machine cycles in one frame: 70224 T-cycles at normal speed.
+const frameCycles = 70224 / 4
+
+// benchmarkProgram is a loop mixing loads, 8-bit ALU operations, CB instructions,
+// jumps, calls and stack operations.
+var benchmarkProgram = map[uint16][]byte{
+    0x0100: {
+        instructions.LDHL_d16, 0x00, 0xC0,
+        instructions.LDD_d8, 0x40,
+        instructions.LDA_HLinc, // Loop.
+        instructions.ADD_B,
+        instructions.XOR_C,
+        instructions.LDC_A,
+        instructions.LDHL_A,
+        instructions.INC_B,
+        instructions.CALL_a16, 0x20, 0x01,
+        instructions.DEC_D,
+        instructions.JRNZ_e, 0xF4,
+        instructions.PREFIX_CB, instructions.SWAP_A,
+        instructions.JP_a16, 0x00, 0x01,
+    },
+    0x0120: {
+        instructions.PUSH_BC,
+        instructions.AND_d8, 0x0F,
+        instructions.CP_d8, 0x07,
+        instructions.POP_BC,
+        instructions.RET,
+    },
+}
+
+// BenchmarkExecuteFrame runs the benchmark program one frame at a time, and reports the
+// emulated clock speed: the real CGB runs at 4.19 MHz at normal speed.
+func BenchmarkExecuteFrame(b *testing.B) {
+    benchmarkExecuteFrame(b, false)
+}
+
+// BenchmarkExecuteFrameBlockCache runs the benchmark program with the block cache, see blocks.go.
+func BenchmarkExecuteFrameBlockCache(b *testing.B) {
+    benchmarkExecuteFrame(b, true)
+}
+
+func benchmarkExecuteFrame(b *testing.B, blockCache bool) {
+
+    cpu := NewCPU(&Memory{})
+    cpu.SetBlockCache(blockCache)
+    for address, code := range benchmarkProgram {
+        copy(ram(cpu)[address:], code)
+    }
+
+    total := 0
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        cyclesUsed, err := cpu.Execute(frameCycles)
+        if err != nil {
+            b.Fatal(err)
+        }
+        total += cyclesUsed
+    }
+
+    b.ReportMetric(float64(total * 4) / b.Elapsed().Seconds() / 1e6, "emulated-MHz")
+}
+
+// BenchmarkExecuteFrameALU runs every 8-bit load and ALU operation (0x40-0xBF, except HALT),
+// where decoding is most of the work, one frame at a time.
+func BenchmarkExecuteFrameALU(b *testing.B) {
+
+    cpu := NewCPU(&Memory{})
+    address := 0x0100
+    for op := 0x40; op < 0xC0; op++ {
+        if op != instructions.HALT {
+            ram(cpu)[address] = byte(op)
+            address++
+        }
+    }
+    copy(ram(cpu)[address:], []byte{instructions.JP_a16, 0x00, 0x01})
+
+    total := 0
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        cyclesUsed, err := cpu.Execute(frameCycles)
+        if err != nil {
+            b.Fatal(err)
+        }
+        total += cyclesUsed
+    }
+
+    b.ReportMetric(float64(total * 4) / b.Elapsed().Seconds() / 1e6, "emulated-MHz")
+}
@@ -0,0 +1,205 @@
+package arc
+
+import "cgbemu/src/instructions"
+
+// The block cache is a performance mode for Execute: straight-line ROM code is decoded once
+// into basic blocks of pre-decoded instructions, which are then replayed without fetching
+// and decoding them again.
+//
+// A block starts at any address in ROM (0x0000-0x7FFF), and ends after the first instruction
+// that jumps, calls, returns, halts, stops or enables interrupts, or after maxBlockLength
+// instructions. Replaying a block is cycle-exact: every machine cycle is still ticked,
+// opcode and operand fetches included, and the interrupts are checked before each instruction,
+// as the interpreter does. Replay stops early when the cycles budget runs out or an
+// interrupt is pending.
+//
+// Any write into ROM, either self-modifying code on a flat memory or a mapper register
+// switching ROM banks, invalidates the whole cache, the current block included.
+// Buses changing ROM contents without a write from the CPU must either call InvalidateBlocks,
+// or report the ROM not plain until it is written again, see PlainMemory.
+
+// Instructions decoded at most in a block.
+const maxBlockLength = 64
+
+// Blocks are only cached in ROM, RAM banks can be switched without a write into the range.
+const blockCacheEnd = 0x8000
+
+// decodedOp is an instruction decoded into a block.
+type decodedOp struct {
+    handler     handler
+    opcode      byte
+
+    // Bytes following the opcode, first one in the low byte. The CB opcode for prefixed instructions.
+    operands    uint16
+    length      byte
+}
+
+// block is a run of straight-line instructions, valid as long as its generation is the cache one.
+type block struct {
+    ops         []decodedOp
+    generation  uint64
+}
+
+// blockCache holds the blocks by start address.
+type blockCache struct {
+    blocks      [blockCacheEnd]*block
+
+    // Incremented on invalidation, so that all the blocks are dropped at once.
+    generation  uint64
+}
+
+// SetBlockCache enables or disables the block cache of Execute, see blocks.go.
+// Step and Cycle always interpret instructions one by one.
+func (cpu *CPU) SetBlockCache(enabled bool) {
+    if !enabled {
+        cpu.blocks = nil
+    } else if cpu.blocks == nil {
+        cpu.blocks = &blockCache{}
+    }
+}
+
+// InvalidateBlocks drops all the cached blocks. It does nothing if the block cache is disabled.
+func (cpu *CPU) InvalidateBlocks() {
+    if cpu.blocks != nil {
+        cpu.blocks.generation++
+    }
+}
+
+// endsBlock reports whether the instruction must be the last one of a block.
+func endsBlock(opcode byte) bool {
+
+    info := instructions.Unprefixed[opcode]
+    if info.Illegal {
+        return true
+    }
+
+    switch info.Mnemonic {
+    case "JP", "JR", "CALL", "RET", "RETI", "RST", "HALT", "STOP", "EI":
+        return true
+    }
+    return false
+}
+
+// decodeBlock decodes the block starting at address. It returns nil if nothing can be cached there.
+// Only plain memory is decoded, its bytes are read without side effects and without consuming cycles.
+func (cpu *CPU) decodeBlock(address uint16) *block {
+
+    b := &block{generation: cpu.blocks.generation}
+
+    for len(b.ops) < maxBlockLength {
+
+        // Anything else is left to the interpreter: unmapped addresses fault, see StrictBus.
+        if !cpu.decodable(address, 1) {
+            break
+        }
+
+        op := decodedOp{opcode: cpu.Bus.Read(address)}
+        op.handler = unprefixedHandlers[op.opcode]
+
+        // The CB opcode is decoded as an operand of the prefix.
+        op.length = byte(instructions.Unprefixed[op.opcode].Length)
+        if op.opcode == instructions.PREFIX_CB {
+            op.length = 2
+        }
+
+        // Instructions crossing the end of ROM are left to the interpreter.
+        if int(address) + int(op.length) > blockCacheEnd {
+            break
+        }
+
+        if !cpu.decodable(address + 1, op.length - 1) {
+            break
+        }
+
+        for i := byte(1); i < op.length; i++ {
+            op.operands |= uint16(cpu.Bus.Read(address + uint16(i))) << (8 * (i - 1))
+        }
+
+        b.ops = append(b.ops, op)
+        address += uint16(op.length)
+
+        if endsBlock(op.opcode) {
+            break
+        }
+    }
+
+    if len(b.ops) == 0 {
+        return nil
+    }
+    return b
+}
+
+// decodable reports whether the length bytes from address can be decoded: mapped on the bus,
+// and plain memory.
+func (cpu *CPU) decodable(address uint16, length byte) bool {
+
+    plain, ok := cpu.Bus.(PlainMemory)
+    for i := uint16(0); i < uint16(length); i++ {
+        if !cpu.mapped(address + i) || ok && !plain.IsPlain(address + i) {
+            return false
+        }
+    }
+    return true
+}
+
+// replayBlock executes the block starting at PC, decoding it first if needed, until the end of the
+// block or of the cycles budget. It returns the machine cycles used, 0 if no instruction was replayed
+// and the CPU must be stepped instead.
+func (cpu *CPU) replayBlock(budget int) int {
+
+    // Anything but plain execution is left to Step.
+    if cpu.current != nil || cpu.fault != nil || cpu.lockedUp || cpu.Halted || cpu.Stopped ||
+        cpu.haltBug || cpu.imeScheduled {
+        return 0
+    }
+
+    pc := cpu.Registers.PC
+    if pc >= blockCacheEnd {
+        return 0
+    }
+
+    cache := cpu.blocks
+    b := cache.blocks[pc]
+    if b == nil || b.generation != cache.generation {
+        if b = cpu.decodeBlock(pc); b == nil {
+            return 0
+        }
+        cache.blocks[pc] = b
+    }
+
+    cpu.cycles = 0
+    for i := range b.ops {
+
+        if -cpu.cycles >= budget {
+            break
+        }
+
+        // Interrupts are serviced by Step.
+        if cpu.IME && cpu.PendingInterrupts() != 0 {
+            break
+        }
+
+        op := &b.ops[i]
+
+        // Fetch the opcode, 1 cycle used, without reading it again.
+        cpu.instructionPC = cpu.Registers.PC
+        cpu.opcode = op.opcode
+        cpu.tick(&cpu.cycles)
+        cpu.Registers.PC++
+
+        // The handler fetches the operands from the block, see FetchByte.
+        cpu.operands = op.operands
+        cpu.prefetched = op.length - 1
+        op.handler(cpu, &cpu.cycles)
+
+        // STOP skips its operand without fetching it.
+        cpu.prefetched = 0
+
+        // A write into ROM invalidated the block being replayed.
+        if cpu.fault != nil || b.generation != cache.generation {
+            break
+        }
+    }
+
+    return -cpu.cycles
+}
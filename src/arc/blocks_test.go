@@ -0,0 +1,248 @@
+package arc
+
+import (
+    "math/rand/v2"
+    "testing"
+
+    "cgbemu/src/cartridge"
+    "cgbemu/src/instructions"
+    "cgbemu/src/mmu"
+)
+
+// interruptingBus is a flat memory requesting all the interrupts every period cycles,
+// so that interrupts are dispatched in the middle of blocks.
+type interruptingBus struct {
+    Memory
+    period  int
+    ticks   int
+}
+
+func (b *interruptingBus) Tick(cycles int) {
+    for i := 0; i < cycles; i++ {
+        b.ticks++
+        if b.ticks % b.period == 0 {
+            b.RAM[IFAddress] |= 0x1F
+        }
+    }
+}
+
+// randomProgram fills the whole memory with random legal opcodes and operands, leaving out
+// STOP, which would wait for a button forever.
+func randomProgram(bus *interruptingBus, seed uint64) {
+
+    random := rand.New(rand.NewPCG(seed, 0))
+    for address := range bus.RAM {
+        op := byte(random.IntN(256))
+        for instructions.Unprefixed[op].Illegal || op == instructions.STOP {
+            op = byte(random.IntN(256))
+        }
+        bus.RAM[address] = op
+    }
+}
+
+// TestBlockCacheMatchesInterpreter runs the same random programs, including self-modifying code
+// and interrupts, with and without the block cache, and checks that the state is identical
+// after every Execute call.
+func TestBlockCacheMatchesInterpreter(t *testing.T) {
+
+    for seed := uint64(1); seed <= 4; seed++ {
+
+        // Given
+        interpreted := &interruptingBus{period: 1000}
+        randomProgram(interpreted, seed)
+        cached := &interruptingBus{}
+        *cached = *interpreted
+
+        want := NewCPU(interpreted)
+        want.cycleStepping = cycleStepping
+        cpu := NewCPU(cached)
+        cpu.SetBlockCache(true)
+
+        budgets := rand.New(rand.NewPCG(seed, 1))
+
+        // When
+        for call := 0; call < 64; call++ {
+
+            budget := 1 + budgets.IntN(frameCycles)
+            wantCycles, wantErr := want.Execute(budget)
+            cyclesUsed, err := cpu.Execute(budget)
+
+            // Then
+            if cyclesUsed != wantCycles || err != wantErr {
+                t.Fatal("Seed ", seed, ", call ", call, ": cycles used: ", cyclesUsed, " (", err,
+                    "), cycles expected: ", wantCycles, " (", wantErr, ")")
+            }
+            if cpu.Registers != want.Registers || cpu.IME != want.IME || cpu.Halted != want.Halted ||
+                cpu.imeScheduled != want.imeScheduled || cpu.haltBug != want.haltBug {
+                t.Fatalf("Seed %d, call %d: CPU state %+v, expected %+v", seed, call, cpu.Registers, want.Registers)
+            }
+            if cached.RAM != interpreted.RAM {
+                t.Fatal("Seed ", seed, ", call ", call, ": memory differs from the interpreter")
+            }
+            if cached.ticks != interpreted.ticks {
+                t.Fatal("Seed ", seed, ", call ", call, ": bus ticked ", cached.ticks, " cycles, expected ", interpreted.ticks)
+            }
+        }
+    }
+}
+
+func TestBlockCacheSeesSelfModifyingCode(t *testing.T) {
+
+    // Given
+    cpu := InitSM83()
+    cpu.SetBlockCache(true)
+    cpu.Registers.H = 0x01
+    cpu.Registers.L = 0x06
+    copy(ram(cpu)[0x0100:], []byte{
+        instructions.LDA_d8, instructions.INC_B,
+        instructions.JP_a16, 0x05, 0x01,
+        instructions.LDHL_A,    // Overwrites the NOP below with INC B.
+        instructions.NOP,
+        instructions.JP_a16, 0x05, 0x01,
+    })
+
+    // When: the block at 0x0105 modifies itself, then runs again.
+    cyclesUsed, err := cpu.Execute(2 + 4 + 2 + 1 + 4 + 2 + 1 + 4)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if cpu.Registers.B != 0x02 {
+        t.Error("B should be 0x02, got ", cpu.Registers.B)
+    }
+    if cyclesUsed != 20 {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", 20)
+    }
+}
+
+// bankedBus maps ram[0x4000 * bank:] at 0x4000-0x7FFF, the bank being selected by
+// writing to 0x2000-0x3FFF.
+type bankedBus struct {
+    Memory
+    bank    int
+    banks   [4][0x4000]byte
+}
+
+func (b *bankedBus) Read(address uint16) byte {
+    if address >= 0x4000 && address < 0x8000 {
+        return b.banks[b.bank][address - 0x4000]
+    }
+    return b.Memory.Read(address)
+}
+
+func (b *bankedBus) Write(address uint16, value byte) {
+    if address >= 0x2000 && address < 0x4000 {
+        b.bank = int(value & 0x03)
+        return
+    }
+    b.Memory.Write(address, value)
+}
+
+func TestBlockCacheFollowsBankSwitch(t *testing.T) {
+
+    // Given
+    bus := &bankedBus{}
+    copy(bus.RAM[0x0100:], []byte{
+        instructions.CALL_a16, 0x00, 0x40,
+        instructions.LDA_d8, 0x02,
+        instructions.LDa16_A, 0x00, 0x20,
+        instructions.CALL_a16, 0x00, 0x40,
+        instructions.HALT,
+    })
+    bus.banks[1][0] = instructions.INC_B
+    bus.banks[1][1] = instructions.RET
+    bus.banks[2][0] = instructions.INC_C
+    bus.banks[2][1] = instructions.RET
+    bus.bank = 1
+
+    cpu := NewCPU(bus)
+    cpu.SetBlockCache(true)
+
+    // When
+    _, err := cpu.Execute(6 + 1 + 4 + 2 + 4 + 6 + 1 + 4 + 1)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if cpu.Registers.B != 0x01 || cpu.Registers.C != 0x01 {
+        t.Error("B and C should be 0x01, got ", cpu.Registers.B, " and ", cpu.Registers.C)
+    }
+    if !cpu.Halted {
+        t.Error("CPU should be halted")
+    }
+}
+
+// TestBlockCacheFollowsFlashErase runs code from a flash cartridge across a sector erase: what is
+// fetched while the chip returns its status must not be replayed once the erase is done.
+func TestBlockCacheFollowsFlashErase(t *testing.T) {
+
+    // Given: a 128 KiB MBC5 ROM with INC B, HALT at the start of bank 4.
+    rom := make([]byte, 8 * cartridge.ROMBankSize)
+    copy(rom[0x0104:], cartridge.Logo[:])
+    rom[0x0147] = 0x19
+    rom[0x0148] = 0x02
+    rom[0x014D] = cartridge.HeaderChecksum(rom)
+    rom[4 * cartridge.ROMBankSize] = instructions.INC_B
+    rom[4 * cartridge.ROMBankSize + 1] = instructions.HALT
+
+    cart, err := cartridge.New(rom)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if err := cart.EnableFlash(); err != nil {
+        t.Fatal(err)
+    }
+
+    bus := mmu.New()
+    bus.LoadCartridge(cart)
+    bus.AddComponent(cart)
+    bus.Write(0x2000, 0x04)
+
+    // The erase sequence of the sector holding bank 4 runs from WRAM, then jumps back to it.
+    writes := []struct {
+        address uint16
+        value   byte
+    }{
+        {0x0555, 0xAA}, {0x02AA, 0x55}, {0x0555, 0x80}, {0x0555, 0xAA}, {0x02AA, 0x55}, {0x4000, 0x30},
+    }
+    var code []byte
+    for _, w := range writes {
+        code = append(code, instructions.LDA_d8, w.value, instructions.LDa16_A, byte(w.address), byte(w.address >> 8))
+    }
+    code = append(code, instructions.JP_a16, 0x00, 0x40)
+    for i, b := range code {
+        bus.Write(0xC000 + uint16(i), b)
+    }
+
+    cpu := NewCPU(bus)
+    cpu.SetBlockCache(true)
+    cpu.Registers.SP = 0xD000
+
+    // When: the code in bank 4 runs, then is erased, and the first status byte is executed.
+    cpu.Registers.PC = 0x4000
+    if _, err := cpu.Execute(2); err != nil {
+        t.Fatal(err)
+    }
+    cpu.Halted = false
+    cpu.Registers.PC = 0xC000
+    if _, err := cpu.Execute(len(writes) * 6 + 4 + 1); err != nil {
+        t.Fatal(err)
+    }
+
+    // When: the erase is done after 1 s, and bank 4 runs again.
+    bus.Tick(1 << 20)
+    cpu.Registers.PC = 0x4000
+    if _, err := cpu.Execute(4); err != nil {
+        t.Fatal(err)
+    }
+
+    // Then: the erased byte is RST 38H.
+    if cpu.Registers.B != 0x01 {
+        t.Error("B should be 0x01, got ", cpu.Registers.B)
+    }
+    if cpu.Registers.PC != 0x0038 {
+        t.Errorf("PC should be 0x0038, got 0x%04X", cpu.Registers.PC)
+    }
+}
@@ -0,0 +1,116 @@
+package arc
+
+// Bus connects the CPU to the rest of the system: memory, I/O registers and every
+// other component. The CPU drives all its memory accesses through it, and tells it
+// how much time has passed.
+type Bus interface {
+    Read(address uint16) byte
+    Write(address uint16, value byte)
+
+    // Tick advances the rest of the system by the given machine cycles.
+    Tick(cycles int)
+}
+
+// tick consumes one machine cycle, advancing the rest of the system with the CPU.
+//
+// Every machine cycle of an instruction goes through tick, in the order the hardware
+// spends them, so bus accesses see the rest of the system as it is on their cycle.
+func (cpu *CPU) tick(cycles *int) {
+
+    // A new machine cycle starts: pause here if the CPU is advanced by Cycle.
+    if *cycles < 0 {
+        cpu.pause()
+    }
+
+    *cycles--
+    cpu.Bus.Tick(1)
+}
+
+// StrictBus is implemented by buses leaving some addresses unmapped, e.g. tools catching
+// the stray accesses of a ROM. An access to an unmapped address faults the CPU with
+// InvalidAccess: reads return open bus and writes are dropped.
+type StrictBus interface {
+
+    // Mapped reports whether anything answers at address.
+    Mapped(address uint16) bool
+}
+
+// mapped reports whether address is mapped on the bus, always true if it is not strict.
+func (cpu *CPU) mapped(address uint16) bool {
+    strict, ok := cpu.Bus.(StrictBus)
+    return !ok || strict.Mapped(address)
+}
+
+// checkAccess reports whether address can be accessed, faulting the CPU if it is unmapped.
+func (cpu *CPU) checkAccess(address uint16) bool {
+    if cpu.mapped(address) {
+        return true
+    }
+    cpu.RaiseFault(InvalidAccess, address)
+    return false
+}
+
+// PlainMemory is implemented by buses whose ROM is not always plain memory, e.g. a flash
+// chip returning its status while busy: reading it has side effects, and what is read changes
+// without a write. The block cache only decodes the addresses reported plain.
+type PlainMemory interface {
+
+    // IsPlain reports whether reading address returns memory content, with no side effects.
+    IsPlain(address uint16) bool
+}
+
+// SpeedSwitcher is implemented by CGB buses, which own the KEY1 register.
+// Without it STOP always stops, and the CPU always runs at normal speed.
+type SpeedSwitcher interface {
+
+    // SwitchSpeed performs an armed speed switch and reports whether one was armed.
+    SwitchSpeed() bool
+
+    IsDoubleSpeed() bool
+}
+
+// KEY1: CGB speed switch register.
+// Bit 7: current speed (0 = normal, 1 = double), bit 0: switch armed, performed by the next STOP.
+const KEY1Address = 0xFF4D
+
+// Memory is a flat 64 KiB bus: 8-bit data bus, 16-bit address bus (output only).
+// Every address is plain RAM and nothing else is ticked, which is all the
+// instruction tests need.
+type Memory struct {
+    RAM    [MaxMem]byte
+}
+
+// ClearRAM sets the whole memory to zero.
+func (m *Memory) ClearRAM(){
+    for i:=0; i<MaxMem; i++ {
+        m.RAM[i] = 0
+    }
+}
+
+func (m *Memory) Read(address uint16) byte {
+    return m.RAM[address]
+}
+
+func (m *Memory) Write(address uint16, value byte) {
+    m.RAM[address] = value
+}
+
+// Tick does nothing, there is nothing else on the bus.
+func (m *Memory) Tick(cycles int) {}
+
+// SwitchSpeed performs an armed speed switch, KEY1 is plain RAM.
+func (m *Memory) SwitchSpeed() bool {
+
+    key1 := m.RAM[KEY1Address]
+    if key1 & 0x01 == 0 {
+        return false
+    }
+
+    // Toggle the current speed and clear the armed bit.
+    m.RAM[KEY1Address] = (key1 ^ 0x80) &^ 0x01
+    return true
+}
+
+func (m *Memory) IsDoubleSpeed() bool {
+    return m.RAM[KEY1Address] & 0x80 != 0
+}
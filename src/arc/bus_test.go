@@ -0,0 +1,144 @@
+package arc
+
+import (
+    "testing"
+
+    "cgbemu/src/instructions"
+    "cgbemu/src/mmu"
+    "cgbemu/src/scheduler"
+)
+
+// romCartridge is a flat 32 KiB ROM with no RAM and no mapper.
+type romCartridge struct {
+    rom [0x8000]byte
+}
+
+func (c *romCartridge) Read(address uint16) byte {
+    if address < 0x8000 {
+        return c.rom[address]
+    }
+    return mmu.OpenBus
+}
+
+func (c *romCartridge) Write(address uint16, value byte) {}
+
+// tickCounter records how many cycles it was ticked for.
+type tickCounter struct {
+    cycles int
+}
+
+func (c *tickCounter) Tick(cycles int) {
+    c.cycles += cycles
+}
+
+func TestCPURunsOnMMU(t *testing.T) {
+
+    // Given
+    cart := &romCartridge{}
+    cart.rom[0x0100] = instructions.LDA_d8
+    cart.rom[0x0101] = 0x42
+    cart.rom[0x0102] = instructions.LDa16_A
+    cart.rom[0x0103] = 0x00
+    cart.rom[0x0104] = 0xC0
+    cart.rom[0x0105] = instructions.LDB_HL
+
+    bus := mmu.New()
+    bus.LoadCartridge(cart)
+    counter := &tickCounter{}
+    bus.AddComponent(counter)
+
+    cpu := NewCPU(bus)
+    cpu.Registers.H = 0xC0
+    cpu.Registers.L = 0x00
+    expectedCycles := 2 + 4 + 2
+
+    // When
+    cyclesUsed := 0
+    for i := 0; i < 3; i++ {
+        used, _, _ := cpu.Step()
+        cyclesUsed += used
+    }
+
+    // Then
+    if cpu.Registers.B != 0x42 {
+        t.Error("B should be 0x42, got ", cpu.Registers.B)
+    }
+    if bus.Read(0xC000) != 0x42 {
+        t.Error("WRAM at 0xC000 should be 0x42, got ", bus.Read(0xC000))
+    }
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+    if counter.cycles != expectedCycles {
+        t.Error("Components ticked for ", counter.cycles, " cycles, expected ", expectedCycles)
+    }
+}
+
+func TestCPUOnMMUCanSwitchSpeed(t *testing.T) {
+
+    // Given
+    cart := &romCartridge{}
+    cart.rom[0x0100] = instructions.STOP
+
+    bus := mmu.New()
+    bus.LoadCartridge(cart)
+    bus.Write(KEY1Address, 0x01)
+    cpu := NewCPU(bus)
+
+    // When
+    cpu.Step()
+
+    // Then
+    if !cpu.IsDoubleSpeed() {
+        t.Error("CPU should be in double speed mode")
+    }
+    if cpu.Stopped {
+        t.Error("CPU should not stop when switching speed")
+    }
+}
+
+// TestSchedulerRunsDuringInstruction verifies that an event due in the middle of an
+// instruction runs before its late accesses, and that STOP switches the scheduler speed.
+func TestSchedulerRunsDuringInstruction(t *testing.T) {
+
+    // Given
+    cart := &romCartridge{}
+    cart.rom[0x0100] = instructions.STOP
+    cart.rom[0x0102] = instructions.PREFIX_CB
+    cart.rom[0x0103] = instructions.BIT0_indHL
+
+    bus := mmu.New()
+    bus.LoadCartridge(cart)
+    bus.Write(KEY1Address, 0x01)
+    sched := scheduler.New()
+    bus.AddComponent(sched)
+
+    cpu := NewCPU(bus)
+    cpu.Registers.H = 0xC0
+    cpu.Registers.L = 0x00
+
+    // When
+    cpu.Step()
+
+    // Then
+    if !sched.IsDoubleSpeed() {
+        t.Fatal("The scheduler should follow the switch to double speed")
+    }
+
+    // Given, an event due on the second machine cycle of BIT 0, (HL) at double speed.
+    e := scheduler.NewEvent("write", func(at uint64) { bus.Write(0xC000, 0x01) })
+    sched.Schedule(e, 2 * scheduler.DoubleSpeedRatio)
+
+    // When
+    cpu.Step()
+
+    // Then
+    if cpu.IsZflagSet() {
+        t.Error("BIT 0, (HL) should see the value written by the event")
+    }
+    // STOP took one machine cycle at normal speed, BIT 0, (HL) three at double speed.
+    expectedNow := uint64(scheduler.NormalSpeedRatio + 3 * scheduler.DoubleSpeedRatio)
+    if sched.Now() != expectedNow {
+        t.Error("Scheduler should be at cycle ", expectedNow, " got ", sched.Now())
+    }
+}
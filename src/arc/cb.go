@@ -0,0 +1,21 @@
+package arc
+
+// ExecuteCB fetches the opcode following the 0xCB prefix and executes it.
+//
+// CB opcodes are encoded as 0bxxyyyzzz, where zzz selects the operand (B, C, D, E, H, L, (HL), A),
+// and xx selects the group: rotates/shifts (yyy selects the operation), BIT, RES and SET (yyy selects the bit).
+//
+// Register operands take 2 machine cycles: prefix + opcode.
+// (HL) operands take 4 machine cycles: prefix + opcode + R + W, except BIT which only reads and takes 3.
+// It returns the CB opcode executed.
+func (cpu *CPU) ExecuteCB(cycles *int) byte {
+
+    op := cpu.FetchByte(cycles)
+    if cpu.current != nil {
+        cpu.current.opcode = 0xCB00 | int(op)
+    }
+
+    cbHandlers[op](cpu, cycles)
+
+    return op
+}
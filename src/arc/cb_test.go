@@ -0,0 +1,446 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"testing"
+)
+
+func TestRLC_B(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.B = 0x85
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_RLC | instructions.CB_B
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.B != 0x0B {
+        t.Error("B register should be 0x0B, instead got: ", cpu.Registers.B)
+    }
+
+    // Z = 0, N = 0, H = 0, C = 1
+    if cpu.Registers.F != 0x10 {
+        t.Error("F register should be 0x10, instead got: ", cpu.Registers.F)
+    }
+}
+
+func TestRRC_C(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.C = 0x01
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_RRC | instructions.CB_C
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.C != 0x80 {
+        t.Error("C register should be 0x80, instead got: ", cpu.Registers.C)
+    }
+
+    if cpu.Registers.F != 0x10 {
+        t.Error("F register should be 0x10, instead got: ", cpu.Registers.F)
+    }
+}
+
+// TestRL_D verifies that the old carry is rotated into bit 0.
+func TestRL_D(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.D = 0x80
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_RL | instructions.CB_D
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.D != 0x00 {
+        t.Error("D register should be 0x00, instead got: ", cpu.Registers.D)
+    }
+
+    // Z = 1, C = 1
+    if cpu.Registers.F != 0x90 {
+        t.Error("F register should be 0x90, instead got: ", cpu.Registers.F)
+    }
+}
+
+func TestRR_E(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.E = 0x02
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_RR | instructions.CB_E
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.E != 0x81 {
+        t.Error("E register should be 0x81, instead got: ", cpu.Registers.E)
+    }
+
+    if cpu.Registers.F != 0x00 {
+        t.Error("F register should be 0x00, instead got: ", cpu.Registers.F)
+    }
+}
+
+func TestSLA_H(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.H = 0xC1
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_SLA | instructions.CB_H
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.H != 0x82 {
+        t.Error("H register should be 0x82, instead got: ", cpu.Registers.H)
+    }
+
+    if cpu.Registers.F != 0x10 {
+        t.Error("F register should be 0x10, instead got: ", cpu.Registers.F)
+    }
+}
+
+// TestSRA_L verifies that bit 7 is preserved.
+func TestSRA_L(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.L = 0x81
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_SRA | instructions.CB_L
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.L != 0xC0 {
+        t.Error("L register should be 0xC0, instead got: ", cpu.Registers.L)
+    }
+
+    if cpu.Registers.F != 0x10 {
+        t.Error("F register should be 0x10, instead got: ", cpu.Registers.F)
+    }
+}
+
+// TestSWAP_A verifies that SWAP always clears the carry.
+func TestSWAP_A(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.A = 0xF1
+    cpu.Registers.F = 0xF0
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_SWAP | instructions.CB_A
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.A != 0x1F {
+        t.Error("A register should be 0x1F, instead got: ", cpu.Registers.A)
+    }
+
+    if cpu.Registers.F != 0x00 {
+        t.Error("F register should be 0x00, instead got: ", cpu.Registers.F)
+    }
+}
+
+func TestSRL_B(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.B = 0x01
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_SRL | instructions.CB_B
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.B != 0x00 {
+        t.Error("B register should be 0x00, instead got: ", cpu.Registers.B)
+    }
+
+    // Z = 1, C = 1
+    if cpu.Registers.F != 0x90 {
+        t.Error("F register should be 0x90, instead got: ", cpu.Registers.F)
+    }
+}
+
+// TestRLC_indHL verifies the read-modify-write timing on (HL).
+func TestRLC_indHL(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.H = 0x80
+    cpu.Registers.L = 0x00
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_RLC | instructions.CB_indHL
+    ram(cpu)[0x8000] = 0x40
+
+    // When
+    expectedCycles := 4
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if ram(cpu)[0x8000] != 0x80 {
+        t.Error("Address 0x8000 should be 0x80, instead got: ", ram(cpu)[0x8000])
+    }
+
+    if cpu.Registers.F != 0x00 {
+        t.Error("F register should be 0x00, instead got: ", cpu.Registers.F)
+    }
+}
+
+// TestBIT_B verifies that BIT sets Z when the bit is reset, sets H, and leaves C untouched.
+func TestBIT_B(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.B = 0xEF
+    cpu.Registers.F = 0x50 // N = 1, C = 1
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_BIT | 4 << 3 | instructions.CB_B
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    // Z = 1, N = 0, H = 1, C = 1
+    if cpu.Registers.F != 0xB0 {
+        t.Error("F register should be 0xB0, instead got: ", cpu.Registers.F)
+    }
+
+    if cpu.Registers.B != 0xEF {
+        t.Error("B register should not change, instead got: ", cpu.Registers.B)
+    }
+}
+
+// TestBIT_indHL verifies that BIT on (HL) only reads memory, taking 3 cycles.
+func TestBIT_indHL(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.H = 0xC0
+    cpu.Registers.L = 0x10
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_BIT | 7 << 3 | instructions.CB_indHL
+    ram(cpu)[0xC010] = 0x80
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    // Z = 0, N = 0, H = 1, C = 0
+    if cpu.Registers.F != 0x20 {
+        t.Error("F register should be 0x20, instead got: ", cpu.Registers.F)
+    }
+}
+
+func TestRES_C(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.C = 0xFF
+    cpu.Registers.F = 0xF0
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_RES | 0 << 3 | instructions.CB_C
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.C != 0xFE {
+        t.Error("C register should be 0xFE, instead got: ", cpu.Registers.C)
+    }
+
+    if cpu.Registers.F != 0xF0 {
+        t.Error("Flags should not change, instead got: ", cpu.Registers.F)
+    }
+}
+
+func TestSET_indHL(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.H = 0xC0
+    cpu.Registers.L = 0x00
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.CB_SET | 5 << 3 | instructions.CB_indHL
+
+    // When
+    expectedCycles := 4
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if ram(cpu)[0xC000] != 0x20 {
+        t.Error("Address 0xC000 should be 0x20, instead got: ", ram(cpu)[0xC000])
+    }
+
+    if cpu.Registers.F != 0x00 {
+        t.Error("Flags should not change, instead got: ", cpu.Registers.F)
+    }
+}
+
+// TestCBCycles verifies the cycles used by every one of the 256 CB opcodes.
+func TestCBCycles(t *testing.T) {
+
+    for op := 0; op < 256; op++ {
+
+        cpu := InitSM83()
+        cpu.Registers.H = 0xC0
+        cpu.Registers.L = 0x00
+
+        // Given
+        ram(cpu)[0x0100] = instructions.PREFIX_CB
+        ram(cpu)[0x0101] = byte(op)
+
+        expectedCycles := 2
+        if op & 0x07 == instructions.CB_indHL {
+            if op & 0xC0 == instructions.CB_BIT {
+                expectedCycles = 3
+            }else {
+                expectedCycles = 4
+            }
+        }
+
+        // When
+        cyclesUsed, err := cpu.Execute(expectedCycles)
+        if err != nil {
+            t.Fatal(err)
+        }
+
+        if cyclesUsed != expectedCycles {
+            t.Error("CB opcode ", op, ": cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+        }
+
+        if cpu.Registers.PC != 0x0102 {
+            t.Error("CB opcode ", op, ": PC should be 0x0102, instead got: ", cpu.Registers.PC)
+        }
+    }
+}
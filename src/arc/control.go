@@ -0,0 +1,83 @@
+package arc
+
+// JumpAbsolute reads the 16-bit operand nn and, if condition holds, jumps to it.
+// It consumes 2 machine cycles for reading nn, plus 1 more if the jump is taken.
+func (cpu *CPU) JumpAbsolute(cycles *int, condition bool) {
+
+    nn := cpu.FetchWord(cycles)
+
+    if condition {
+
+        // Loading nn into PC takes one internal cycle.
+        cpu.Registers.PC = nn
+        cpu.tick(cycles)
+    }
+}
+
+// JumpRelative reads the signed 8-bit operand e and, if condition holds, adds it to PC.
+// PC already points to the next instruction when e is added.
+// It consumes 1 machine cycle for reading e, plus 1 more if the jump is taken.
+func (cpu *CPU) JumpRelative(cycles *int, condition bool) {
+
+    e := int8(cpu.FetchByte(cycles))
+
+    if condition {
+
+        cpu.Registers.PC = uint16(int32(cpu.Registers.PC) + int32(e))
+        cpu.tick(cycles)
+    }
+}
+
+// Call reads the 16-bit operand nn and, if condition holds, pushes PC to the stack and jumps to nn.
+// It consumes 2 machine cycles for reading nn, plus 3 more if the call is taken.
+func (cpu *CPU) Call(cycles *int, condition bool) {
+
+    nn := cpu.FetchWord(cycles)
+
+    if condition {
+
+        // A cycle is consumed just for decrementing SP.
+        cpu.tick(cycles)
+
+        // Push MSB first, so that the return address is little-endian in memory.
+        cpu.PushToSP(cycles, byte(cpu.Registers.PC >> 8))
+        cpu.PushToSP(cycles, byte(cpu.Registers.PC & 0xFF))
+
+        cpu.Registers.PC = nn
+    }
+}
+
+// Return pops the return address from the stack into PC.
+// It consumes 3 machine cycles: R(lsb), R(msb) and one internal cycle to load PC.
+func (cpu *CPU) Return(cycles *int) {
+
+    lsb := cpu.PopFromSP(cycles)
+    msb := cpu.PopFromSP(cycles)
+
+    cpu.Registers.PC = GetUint16AddressFromLSBAndMSB(lsb, msb)
+    cpu.tick(cycles)
+}
+
+// ReturnIf checks condition and, if it holds, returns from the subroutine.
+// Checking the condition consumes 1 machine cycle, a taken return consumes 3 more.
+func (cpu *CPU) ReturnIf(cycles *int, condition bool) {
+
+    cpu.tick(cycles)
+
+    if condition {
+        cpu.Return(cycles)
+    }
+}
+
+// Restart pushes PC to the stack and jumps to the fixed address vector (0x00, 0x08, ..., 0x38).
+// It consumes 3 machine cycles: one internal cycle, W(msb), W(lsb).
+func (cpu *CPU) Restart(cycles *int, vector uint16) {
+
+    // A cycle is consumed just for decrementing SP.
+    cpu.tick(cycles)
+
+    cpu.PushToSP(cycles, byte(cpu.Registers.PC >> 8))
+    cpu.PushToSP(cycles, byte(cpu.Registers.PC & 0xFF))
+
+    cpu.Registers.PC = vector
+}
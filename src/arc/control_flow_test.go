@@ -0,0 +1,1205 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"testing"
+)
+
+func TestJP_a16(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.JP_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 4
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x1234 {
+        t.Error("PC should be 0x1234, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestJPNZ_a16Taken verifies that JP NZ jumps to nn when the Z flag is not set.
+func TestJPNZ_a16Taken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.JPNZ_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 4
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x1234 {
+        t.Error("PC should be 0x1234, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestJPNZ_a16NotTaken verifies that JP NZ falls through when the Z flag is set.
+func TestJPNZ_a16NotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x80
+    ram(cpu)[0x0100] = instructions.JPNZ_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0103 {
+        t.Error("PC should be 0x0103, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestJPZ_a16Taken verifies that JP Z jumps to nn when the Z flag is set.
+func TestJPZ_a16Taken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x80
+    ram(cpu)[0x0100] = instructions.JPZ_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 4
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x1234 {
+        t.Error("PC should be 0x1234, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestJPZ_a16NotTaken verifies that JP Z falls through when the Z flag is not set.
+func TestJPZ_a16NotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.JPZ_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0103 {
+        t.Error("PC should be 0x0103, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestJPNC_a16Taken verifies that JP NC jumps to nn when the C flag is not set.
+func TestJPNC_a16Taken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.JPNC_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 4
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x1234 {
+        t.Error("PC should be 0x1234, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestJPNC_a16NotTaken verifies that JP NC falls through when the C flag is set.
+func TestJPNC_a16NotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.JPNC_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0103 {
+        t.Error("PC should be 0x0103, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestJPC_a16Taken verifies that JP C jumps to nn when the C flag is set.
+func TestJPC_a16Taken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.JPC_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 4
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x1234 {
+        t.Error("PC should be 0x1234, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestJPC_a16NotTaken verifies that JP C falls through when the C flag is not set.
+func TestJPC_a16NotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.JPC_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0103 {
+        t.Error("PC should be 0x0103, instead got: ", cpu.Registers.PC)
+    }
+}
+
+func TestJP_HL(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.H = 0x45
+    cpu.Registers.L = 0x67
+    ram(cpu)[0x0100] = instructions.JP_HL
+
+    // When
+    expectedCycles := 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x4567 {
+        t.Error("PC should be 0x4567, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestJR_eForward verifies that the offset is added to the address of the next instruction.
+func TestJR_eForward(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.JR_e
+    ram(cpu)[0x0101] = 0x05
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    // 0x0102 + 5
+    if cpu.Registers.PC != 0x0107 {
+        t.Error("PC should be 0x0107, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestJR_eBackward verifies that the offset is treated as a signed byte.
+func TestJR_eBackward(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.JR_e
+    ram(cpu)[0x0101] = 0xFE // -2, jumps back to the JR opcode itself.
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0100 {
+        t.Error("PC should be 0x0100, instead got: ", cpu.Registers.PC)
+    }
+}
+
+func TestJRNZ_eTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.JRNZ_e
+    ram(cpu)[0x0101] = 0xF0 // -16
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x00F2 {
+        t.Error("PC should be 0x00F2, instead got: ", cpu.Registers.PC)
+    }
+}
+
+func TestJRNZ_eNotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x80
+    ram(cpu)[0x0100] = instructions.JRNZ_e
+    ram(cpu)[0x0101] = 0xF0 // -16
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0102 {
+        t.Error("PC should be 0x0102, instead got: ", cpu.Registers.PC)
+    }
+}
+
+func TestJRZ_eTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x80
+    ram(cpu)[0x0100] = instructions.JRZ_e
+    ram(cpu)[0x0101] = 0xF0 // -16
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x00F2 {
+        t.Error("PC should be 0x00F2, instead got: ", cpu.Registers.PC)
+    }
+}
+
+func TestJRZ_eNotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.JRZ_e
+    ram(cpu)[0x0101] = 0xF0 // -16
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0102 {
+        t.Error("PC should be 0x0102, instead got: ", cpu.Registers.PC)
+    }
+}
+
+func TestJRNC_eTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.JRNC_e
+    ram(cpu)[0x0101] = 0xF0 // -16
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x00F2 {
+        t.Error("PC should be 0x00F2, instead got: ", cpu.Registers.PC)
+    }
+}
+
+func TestJRNC_eNotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.JRNC_e
+    ram(cpu)[0x0101] = 0xF0 // -16
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0102 {
+        t.Error("PC should be 0x0102, instead got: ", cpu.Registers.PC)
+    }
+}
+
+func TestJRC_eTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.JRC_e
+    ram(cpu)[0x0101] = 0xF0 // -16
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x00F2 {
+        t.Error("PC should be 0x00F2, instead got: ", cpu.Registers.PC)
+    }
+}
+
+func TestJRC_eNotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.JRC_e
+    ram(cpu)[0x0101] = 0xF0 // -16
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0102 {
+        t.Error("PC should be 0x0102, instead got: ", cpu.Registers.PC)
+    }
+}
+
+func TestCALL_a16(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    // SP = 0xFFFE
+    initialSP := cpu.Registers.SP
+    ram(cpu)[0x0100] = instructions.CALL_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 6
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x1234 {
+        t.Error("PC should be 0x1234, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != initialSP - 2 {
+        t.Error("SP should be ", initialSP - 2, ", instead got: ", cpu.Registers.SP)
+    }
+
+    // Return address is the instruction following CALL, 0x0103.
+    if ram(cpu)[initialSP - 1] != 0x01 {
+        t.Error("Contents at SP-1 should be 0x01, instead got: ", ram(cpu)[initialSP - 1])
+    }
+
+    if ram(cpu)[initialSP - 2] != 0x03 {
+        t.Error("Contents at SP-2 should be 0x03, instead got: ", ram(cpu)[initialSP - 2])
+    }
+}
+
+func TestCALLNZ_a16Taken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    initialSP := cpu.Registers.SP
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.CALLNZ_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 6
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x1234 {
+        t.Error("PC should be 0x1234, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != initialSP - 2 {
+        t.Error("SP should be ", initialSP - 2, ", instead got: ", cpu.Registers.SP)
+    }
+
+    if ram(cpu)[initialSP - 1] != 0x01 || ram(cpu)[initialSP - 2] != 0x03 {
+        t.Error("Return address 0x0103 should be pushed to the stack.")
+    }
+}
+
+func TestCALLNZ_a16NotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    initialSP := cpu.Registers.SP
+    cpu.Registers.F = 0x80
+    ram(cpu)[0x0100] = instructions.CALLNZ_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0103 {
+        t.Error("PC should be 0x0103, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != initialSP {
+        t.Error("SP should not change, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestCALLZ_a16Taken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    initialSP := cpu.Registers.SP
+    cpu.Registers.F = 0x80
+    ram(cpu)[0x0100] = instructions.CALLZ_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 6
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x1234 {
+        t.Error("PC should be 0x1234, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != initialSP - 2 {
+        t.Error("SP should be ", initialSP - 2, ", instead got: ", cpu.Registers.SP)
+    }
+
+    if ram(cpu)[initialSP - 1] != 0x01 || ram(cpu)[initialSP - 2] != 0x03 {
+        t.Error("Return address 0x0103 should be pushed to the stack.")
+    }
+}
+
+func TestCALLZ_a16NotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    initialSP := cpu.Registers.SP
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.CALLZ_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0103 {
+        t.Error("PC should be 0x0103, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != initialSP {
+        t.Error("SP should not change, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestCALLNC_a16Taken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    initialSP := cpu.Registers.SP
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.CALLNC_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 6
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x1234 {
+        t.Error("PC should be 0x1234, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != initialSP - 2 {
+        t.Error("SP should be ", initialSP - 2, ", instead got: ", cpu.Registers.SP)
+    }
+
+    if ram(cpu)[initialSP - 1] != 0x01 || ram(cpu)[initialSP - 2] != 0x03 {
+        t.Error("Return address 0x0103 should be pushed to the stack.")
+    }
+}
+
+func TestCALLNC_a16NotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    initialSP := cpu.Registers.SP
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.CALLNC_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0103 {
+        t.Error("PC should be 0x0103, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != initialSP {
+        t.Error("SP should not change, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestCALLC_a16Taken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    initialSP := cpu.Registers.SP
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.CALLC_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 6
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x1234 {
+        t.Error("PC should be 0x1234, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != initialSP - 2 {
+        t.Error("SP should be ", initialSP - 2, ", instead got: ", cpu.Registers.SP)
+    }
+
+    if ram(cpu)[initialSP - 1] != 0x01 || ram(cpu)[initialSP - 2] != 0x03 {
+        t.Error("Return address 0x0103 should be pushed to the stack.")
+    }
+}
+
+func TestCALLC_a16NotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    initialSP := cpu.Registers.SP
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.CALLC_a16
+    ram(cpu)[0x0101] = 0x34
+    ram(cpu)[0x0102] = 0x12
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0103 {
+        t.Error("PC should be 0x0103, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != initialSP {
+        t.Error("SP should not change, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestRET(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    ram(cpu)[0x0100] = instructions.RET
+    ram(cpu)[0xFFFC] = 0x78
+    ram(cpu)[0xFFFD] = 0x56
+
+    // When
+    expectedCycles := 4
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x5678 {
+        t.Error("PC should be 0x5678, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != 0xFFFE {
+        t.Error("SP should be 0xFFFE, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestRETNZTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.RETNZ
+    ram(cpu)[0xFFFC] = 0x78
+    ram(cpu)[0xFFFD] = 0x56
+
+    // When
+    expectedCycles := 5
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x5678 {
+        t.Error("PC should be 0x5678, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != 0xFFFE {
+        t.Error("SP should be 0xFFFE, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestRETNZNotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    cpu.Registers.F = 0x80
+    ram(cpu)[0x0100] = instructions.RETNZ
+    ram(cpu)[0xFFFC] = 0x78
+    ram(cpu)[0xFFFD] = 0x56
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0101 {
+        t.Error("PC should be 0x0101, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != 0xFFFC {
+        t.Error("SP should be 0xFFFC, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestRETZTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    cpu.Registers.F = 0x80
+    ram(cpu)[0x0100] = instructions.RETZ
+    ram(cpu)[0xFFFC] = 0x78
+    ram(cpu)[0xFFFD] = 0x56
+
+    // When
+    expectedCycles := 5
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x5678 {
+        t.Error("PC should be 0x5678, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != 0xFFFE {
+        t.Error("SP should be 0xFFFE, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestRETZNotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.RETZ
+    ram(cpu)[0xFFFC] = 0x78
+    ram(cpu)[0xFFFD] = 0x56
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0101 {
+        t.Error("PC should be 0x0101, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != 0xFFFC {
+        t.Error("SP should be 0xFFFC, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestRETNCTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.RETNC
+    ram(cpu)[0xFFFC] = 0x78
+    ram(cpu)[0xFFFD] = 0x56
+
+    // When
+    expectedCycles := 5
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x5678 {
+        t.Error("PC should be 0x5678, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != 0xFFFE {
+        t.Error("SP should be 0xFFFE, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestRETNCNotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.RETNC
+    ram(cpu)[0xFFFC] = 0x78
+    ram(cpu)[0xFFFD] = 0x56
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0101 {
+        t.Error("PC should be 0x0101, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != 0xFFFC {
+        t.Error("SP should be 0xFFFC, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestRETCTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.RETC
+    ram(cpu)[0xFFFC] = 0x78
+    ram(cpu)[0xFFFD] = 0x56
+
+    // When
+    expectedCycles := 5
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x5678 {
+        t.Error("PC should be 0x5678, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != 0xFFFE {
+        t.Error("SP should be 0xFFFE, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestRETCNotTaken(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.RETC
+    ram(cpu)[0xFFFC] = 0x78
+    ram(cpu)[0xFFFD] = 0x56
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0101 {
+        t.Error("PC should be 0x0101, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != 0xFFFC {
+        t.Error("SP should be 0xFFFC, instead got: ", cpu.Registers.SP)
+    }
+}
+
+func TestRETI(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    ram(cpu)[0x0100] = instructions.RETI
+    ram(cpu)[0xFFFC] = 0x78
+    ram(cpu)[0xFFFD] = 0x56
+
+    // When
+    expectedCycles := 4
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x5678 {
+        t.Error("PC should be 0x5678, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestRST verifies that every restart opcode pushes the return address and jumps to its own vector.
+func TestRST(t *testing.T) {
+
+    vectors := map[byte]uint16{
+        instructions.RST_00: 0x00,
+        instructions.RST_08: 0x08,
+        instructions.RST_10: 0x10,
+        instructions.RST_18: 0x18,
+        instructions.RST_20: 0x20,
+        instructions.RST_28: 0x28,
+        instructions.RST_30: 0x30,
+        instructions.RST_38: 0x38,
+    }
+
+    for opcode, vector := range vectors {
+
+        cpu := InitSM83()
+
+        // Given
+        initialSP := cpu.Registers.SP
+        ram(cpu)[0x0100] = opcode
+
+        // When
+        expectedCycles := 4
+        cyclesUsed, err := cpu.Execute(expectedCycles)
+        if err != nil {
+            t.Fatal(err)
+        }
+
+        if cyclesUsed != expectedCycles {
+            t.Error("Opcode ", opcode, ": cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+        }
+
+        if cpu.Registers.PC != vector {
+            t.Error("Opcode ", opcode, ": PC should be ", vector, ", instead got: ", cpu.Registers.PC)
+        }
+
+        if ram(cpu)[initialSP - 1] != 0x01 || ram(cpu)[initialSP - 2] != 0x01 {
+            t.Error("Opcode ", opcode, ": return address 0x0101 should be pushed to the stack.")
+        }
+    }
+}
+
+// TestCALLThenRET verifies that a CALL followed by a RET resumes right after the CALL.
+func TestCALLThenRET(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.CALL_a16
+    ram(cpu)[0x0101] = 0x00
+    ram(cpu)[0x0102] = 0x20
+    ram(cpu)[0x2000] = instructions.RET
+
+    // When
+    expectedCycles := 6 + 4
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0103 {
+        t.Error("PC should be 0x0103, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.Registers.SP != 0xFFFE {
+        t.Error("SP should be back to 0xFFFE, instead got: ", cpu.Registers.SP)
+    }
+}
@@ -10,13 +10,16 @@ func TestLDBImmediate(t *testing.T) {
     cpu := InitSM83()
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDB_IM
-    cpu.Memory.RAM[0x0101] = 0xF2
+    ram(cpu)[0x0100] = instructions.LDB_IM
+    ram(cpu)[0x0101] = 0xF2
 
-    // Setting more cycles than needed, will make the Execute() return with "unknown opcode: 0".
+    // Setting more cycles than needed, will make the Execute() run past the instruction.
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -34,12 +37,15 @@ func TestLDB_HL(t *testing.T) {
     cpu.Registers.L = 0x8F
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDB_HL
-    cpu.Memory.RAM[0x808F] = 0x20
+    ram(cpu)[0x0100] = instructions.LDB_HL
+    ram(cpu)[0x808F] = 0x20
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -55,13 +61,16 @@ func TestLDBC_d16(t *testing.T) {
     cpu := InitSM83()
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDBC_d16
-    cpu.Memory.RAM[0x0101] = 0x52
-    cpu.Memory.RAM[0x0102] = 0x72
+    ram(cpu)[0x0100] = instructions.LDBC_d16
+    ram(cpu)[0x0101] = 0x52
+    ram(cpu)[0x0102] = 0x72
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -82,25 +91,28 @@ func TestA16_SP(t *testing.T) {
     
     // Given
     // SP = 0xFFFE
-    cpu.Memory.RAM[0x0100] = instructions.LDa16_SP
-    cpu.Memory.RAM[0x0101] = 0x52
-    cpu.Memory.RAM[0x0102] = 0x72
+    ram(cpu)[0x0100] = instructions.LDa16_SP
+    ram(cpu)[0x0101] = 0x52
+    ram(cpu)[0x0102] = 0x72
 
     // 0x5555 data into 7252
 
     // When
     expectedCycles := 5
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[0x7252] != 0xFE {
+    if ram(cpu)[0x7252] != 0xFE {
         t.Error("Address 0x7252 should be 0xFE.")
     }
 
-    if cpu.Memory.RAM[0x7253] != 0xFF {
+    if ram(cpu)[0x7253] != 0xFF {
         t.Error("Address 0x7253 should be 0xFF.")
     }
 }
@@ -114,14 +126,17 @@ func TestLDA_BC(t *testing.T) {
     cpu.Registers.C = 0xF6
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_BC
-    cpu.Memory.RAM[0x56F6] = 0x55
+    ram(cpu)[0x0100] = instructions.LDA_BC
+    ram(cpu)[0x56F6] = 0x55
 
     // 0x5555 data into 7252
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -140,19 +155,22 @@ func TestLDBC_A (t *testing.T) {
     // A = 0x11
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDBC_A
+    ram(cpu)[0x0100] = instructions.LDBC_A
 
     // 0x5555 data into 7252
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[cpu.BC()] != 0x11 {
+    if ram(cpu)[cpu.BC()] != 0x11 {
         t.Error("BC register should be 0x11.")
     }
 
@@ -165,19 +183,22 @@ func TestLDDE_A (t *testing.T) {
     // A = 0x11
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDDE_A
+    ram(cpu)[0x0100] = instructions.LDDE_A
 
     // 0x5555 data into 7252
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[cpu.DE()] != 0x11 {
+    if ram(cpu)[cpu.DE()] != 0x11 {
         t.Error("BC register should be 0x11.")
     }
 
@@ -188,13 +209,16 @@ func TestLDC_d8(t *testing.T) {
     cpu := InitSM83()
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDC_d8
-    cpu.Memory.RAM[0x0101] = 0x33
+    ram(cpu)[0x0100] = instructions.LDC_d8
+    ram(cpu)[0x0101] = 0x33
 
-    // Setting more cycles than needed, will make the Execute() return with "unknown opcode: 0".
+    // Setting more cycles than needed, will make the Execute() run past the instruction.
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -210,13 +234,16 @@ func TestLDDE_d16(t *testing.T) {
     cpu := InitSM83()
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDDE_d16
-    cpu.Memory.RAM[0x0101] = 0x52
-    cpu.Memory.RAM[0x0102] = 0x72
+    ram(cpu)[0x0100] = instructions.LDDE_d16
+    ram(cpu)[0x0101] = 0x52
+    ram(cpu)[0x0102] = 0x72
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -232,13 +259,16 @@ func TestLD_d8(t *testing.T) {
     cpu := InitSM83()
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDD_d8
-    cpu.Memory.RAM[0x0101] = 0x33
+    ram(cpu)[0x0100] = instructions.LDD_d8
+    ram(cpu)[0x0101] = 0x33
 
-    // Setting more cycles than needed, will make the Execute() return with "unknown opcode: 0".
+    // Setting more cycles than needed, will make the Execute() run past the instruction.
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -258,14 +288,17 @@ func TestLDA_DE(t *testing.T) {
     cpu.Registers.E = 0xF6
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_DE
-    cpu.Memory.RAM[0x56F6] = 0x55
+    ram(cpu)[0x0100] = instructions.LDA_DE
+    ram(cpu)[0x56F6] = 0x55
 
     // 0x5555 data into 7252
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -281,13 +314,16 @@ func TestLDE_d8(t *testing.T) {
     cpu := InitSM83()
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDE_d8
-    cpu.Memory.RAM[0x0101] = 0x33
+    ram(cpu)[0x0100] = instructions.LDE_d8
+    ram(cpu)[0x0101] = 0x33
 
-    // Setting more cycles than needed, will make the Execute() return with "unknown opcode: 0".
+    // Setting more cycles than needed, will make the Execute() run past the instruction.
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -303,13 +339,16 @@ func TestLDHL_d16(t *testing.T) {
     cpu := InitSM83()
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_d16
-    cpu.Memory.RAM[0x0101] = 0x52
-    cpu.Memory.RAM[0x0102] = 0x72
+    ram(cpu)[0x0100] = instructions.LDHL_d16
+    ram(cpu)[0x0101] = 0x52
+    ram(cpu)[0x0102] = 0x72
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -328,11 +367,14 @@ func TestLDHLinc_A(t *testing.T) {
     // A=0x11
     cpu.Registers.H = 0x60
     cpu.Registers.L = 0x62
-    cpu.Memory.RAM[0x0100] = instructions.LDHLinc_A
+    ram(cpu)[0x0100] = instructions.LDHLinc_A
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -343,8 +385,8 @@ func TestLDHLinc_A(t *testing.T) {
         t.Error("HL register should be 0x6063, instead got: ", cpu.HL())
     }
 
-    if cpu.Memory.RAM[cpu.HL()-1] != 0x11 {
-        t.Error("Memory address at HL - 1 should be 0x11, instead got: ", cpu.Memory.RAM[cpu.HL()-1])
+    if ram(cpu)[cpu.HL()-1] != 0x11 {
+        t.Error("Memory address at HL - 1 should be 0x11, instead got: ", ram(cpu)[cpu.HL()-1])
     }
 }
 
@@ -353,13 +395,16 @@ func TestLDH_d8(t *testing.T) {
     cpu := InitSM83()
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDH_d8
-    cpu.Memory.RAM[0x0101] = 0x33
+    ram(cpu)[0x0100] = instructions.LDH_d8
+    ram(cpu)[0x0101] = 0x33
 
-    // Setting more cycles than needed, will make the Execute() return with "unknown opcode: 0".
+    // Setting more cycles than needed, will make the Execute() run past the instruction.
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -378,12 +423,15 @@ func TestLDA_HLinc(t *testing.T) {
     // A=0x11
     cpu.Registers.H = 0x60
     cpu.Registers.L = 0x62
-    cpu.Memory.RAM[0x0100] = instructions.LDA_HLinc
-    cpu.Memory.RAM[0x6062] = 0x58
+    ram(cpu)[0x0100] = instructions.LDA_HLinc
+    ram(cpu)[0x6062] = 0x58
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -410,12 +458,15 @@ func TestLDA_HLincExceedsUint16(t *testing.T) {
     // A=0x11
     cpu.Registers.H = 0xFF
     cpu.Registers.L = 0xFF
-    cpu.Memory.RAM[0x0100] = instructions.LDA_HLinc
-    cpu.Memory.RAM[0x6062] = 0x58
+    ram(cpu)[0x0100] = instructions.LDA_HLinc
+    ram(cpu)[0x6062] = 0x58
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -438,13 +489,16 @@ func TestLDL_d8(t *testing.T) {
     cpu := InitSM83()
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDL_d8
-    cpu.Memory.RAM[0x0101] = 0x33
+    ram(cpu)[0x0100] = instructions.LDL_d8
+    ram(cpu)[0x0101] = 0x33
 
-    // Setting more cycles than needed, will make the Execute() return with "unknown opcode: 0".
+    // Setting more cycles than needed, will make the Execute() run past the instruction.
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -463,11 +517,14 @@ func TestLDHLdec_A(t *testing.T) {
     // A=0x11
     cpu.Registers.H = 0x60
     cpu.Registers.L = 0x62
-    cpu.Memory.RAM[0x0100] = instructions.LDHLdec_A
+    ram(cpu)[0x0100] = instructions.LDHLdec_A
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -478,8 +535,8 @@ func TestLDHLdec_A(t *testing.T) {
         t.Error("HL register should be 0x6061, instead got: ", cpu.HL())
     }
 
-    if cpu.Memory.RAM[cpu.HL()+1] != 0x11 {
-        t.Error("Memory address at HL - 1 should be 0x11, instead got: ", cpu.Memory.RAM[cpu.HL()-1])
+    if ram(cpu)[cpu.HL()+1] != 0x11 {
+        t.Error("Memory address at HL - 1 should be 0x11, instead got: ", ram(cpu)[cpu.HL()-1])
     }
 }
 
@@ -491,12 +548,15 @@ func TestLDA_HLdec(t *testing.T) {
     // A=0x11
     cpu.Registers.H = 0x60
     cpu.Registers.L = 0x62
-    cpu.Memory.RAM[0x0100] = instructions.LDA_HLdec
-    cpu.Memory.RAM[0x6062] = 0x58
+    ram(cpu)[0x0100] = instructions.LDA_HLdec
+    ram(cpu)[0x6062] = 0x58
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -518,13 +578,16 @@ func TestLDA_d8(t *testing.T) {
     cpu := InitSM83()
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_d8
-    cpu.Memory.RAM[0x0101] = 0x33
+    ram(cpu)[0x0100] = instructions.LDA_d8
+    ram(cpu)[0x0101] = 0x33
 
-    // Setting more cycles than needed, will make the Execute() return with "unknown opcode: 0".
+    // Setting more cycles than needed, will make the Execute() run past the instruction.
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -541,11 +604,14 @@ func TestLDB_B(t *testing.T) {
     cpu.Registers.B = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDB_B
+    ram(cpu)[0x0100] = instructions.LDB_B
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -562,11 +628,14 @@ func TestLDB_C(t *testing.T) {
     cpu.Registers.C = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDB_C
+    ram(cpu)[0x0100] = instructions.LDB_C
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -582,11 +651,14 @@ func TestLDB_D(t *testing.T) {
     cpu.Registers.D = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDB_D
+    ram(cpu)[0x0100] = instructions.LDB_D
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -602,11 +674,14 @@ func TestLDB_H(t *testing.T) {
     cpu.Registers.H = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDB_H
+    ram(cpu)[0x0100] = instructions.LDB_H
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -622,11 +697,14 @@ func TestLDB_L(t *testing.T) {
     cpu.Registers.L = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDB_L
+    ram(cpu)[0x0100] = instructions.LDB_L
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -642,11 +720,14 @@ func TestLDB_E(t *testing.T) {
     cpu.Registers.E = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDB_E
+    ram(cpu)[0x0100] = instructions.LDB_E
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -663,11 +744,14 @@ func TestLDB_A(t *testing.T) {
     cpu.Registers.A = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDB_A
+    ram(cpu)[0x0100] = instructions.LDB_A
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -684,11 +768,14 @@ func TestLDC_B(t *testing.T) {
     cpu.Registers.B = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDC_B
+    ram(cpu)[0x0100] = instructions.LDC_B
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -705,11 +792,14 @@ func TestLDC_C(t *testing.T) {
     cpu.Registers.C = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDC_C
+    ram(cpu)[0x0100] = instructions.LDC_C
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -726,11 +816,14 @@ func TestLDC_D(t *testing.T) {
     cpu.Registers.D = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDC_D
+    ram(cpu)[0x0100] = instructions.LDC_D
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -747,11 +840,14 @@ func TestLDC_E(t *testing.T) {
     cpu.Registers.E = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDC_E
+    ram(cpu)[0x0100] = instructions.LDC_E
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -768,11 +864,14 @@ func TestLDC_H(t *testing.T) {
     cpu.Registers.H = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDC_H
+    ram(cpu)[0x0100] = instructions.LDC_H
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -789,11 +888,14 @@ func TestLDC_L(t *testing.T) {
     cpu.Registers.L = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDC_L
+    ram(cpu)[0x0100] = instructions.LDC_L
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -811,12 +913,15 @@ func TestLDC_HL(t *testing.T) {
     cpu.Registers.L = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDC_HL
-    cpu.Memory.RAM[0x2069] = 0x69
+    ram(cpu)[0x0100] = instructions.LDC_HL
+    ram(cpu)[0x2069] = 0x69
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -833,11 +938,14 @@ func TestLDC_A(t *testing.T) {
     cpu.Registers.A = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDC_A
+    ram(cpu)[0x0100] = instructions.LDC_A
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -854,11 +962,14 @@ func TestLDD_B(t *testing.T) {
     cpu.Registers.B = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDD_B
+    ram(cpu)[0x0100] = instructions.LDD_B
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -875,11 +986,14 @@ func TestLDD_C(t *testing.T) {
     cpu.Registers.C = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDD_C
+    ram(cpu)[0x0100] = instructions.LDD_C
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -896,11 +1010,14 @@ func TestLDD_D(t *testing.T) {
     cpu.Registers.D = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDD_D
+    ram(cpu)[0x0100] = instructions.LDD_D
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -917,11 +1034,14 @@ func TestLDD_E(t *testing.T) {
     cpu.Registers.E = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDD_E
+    ram(cpu)[0x0100] = instructions.LDD_E
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -938,11 +1058,14 @@ func TestLDD_H(t *testing.T) {
     cpu.Registers.H = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDD_H
+    ram(cpu)[0x0100] = instructions.LDD_H
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -959,11 +1082,14 @@ func TestLDD_L(t *testing.T) {
     cpu.Registers.L = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDD_L
+    ram(cpu)[0x0100] = instructions.LDD_L
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -981,12 +1107,15 @@ func TestLDD_HL(t *testing.T) {
     cpu.Registers.L = 0x8F
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDD_HL
-    cpu.Memory.RAM[0x808F] = 0x20
+    ram(cpu)[0x0100] = instructions.LDD_HL
+    ram(cpu)[0x808F] = 0x20
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1003,11 +1132,14 @@ func TestLDD_A(t *testing.T) {
     cpu.Registers.A = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDD_A
+    ram(cpu)[0x0100] = instructions.LDD_A
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1024,11 +1156,14 @@ func TestLDE_B(t *testing.T) {
     cpu.Registers.B = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDE_B
+    ram(cpu)[0x0100] = instructions.LDE_B
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1045,11 +1180,14 @@ func TestLDE_C(t *testing.T) {
     cpu.Registers.C = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDE_C
+    ram(cpu)[0x0100] = instructions.LDE_C
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1065,11 +1203,14 @@ func TestLDE_D(t *testing.T) {
     cpu.Registers.D = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDE_D
+    ram(cpu)[0x0100] = instructions.LDE_D
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1085,11 +1226,14 @@ func TestLDE_E(t *testing.T) {
     cpu.Registers.E = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDE_E
+    ram(cpu)[0x0100] = instructions.LDE_E
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1105,11 +1249,14 @@ func TestLDE_H(t *testing.T) {
     cpu.Registers.H = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDE_H
+    ram(cpu)[0x0100] = instructions.LDE_H
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1125,11 +1272,14 @@ func TestLDE_L(t *testing.T) {
     cpu.Registers.L = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDE_L
+    ram(cpu)[0x0100] = instructions.LDE_L
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1147,12 +1297,15 @@ func TestLDE_HL(t *testing.T) {
     cpu.Registers.L = 0x8F
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDE_HL
-    cpu.Memory.RAM[0x808F] = 0x20
+    ram(cpu)[0x0100] = instructions.LDE_HL
+    ram(cpu)[0x808F] = 0x20
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1170,11 +1323,14 @@ func TestLDE_A(t *testing.T) {
     cpu.Registers.A = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDE_A
+    ram(cpu)[0x0100] = instructions.LDE_A
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1191,11 +1347,14 @@ func TestLDH_B(t *testing.T) {
     cpu.Registers.B = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDH_B
+    ram(cpu)[0x0100] = instructions.LDH_B
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1212,11 +1371,14 @@ func TestLDH_C(t *testing.T) {
     cpu.Registers.C = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDH_C
+    ram(cpu)[0x0100] = instructions.LDH_C
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1233,11 +1395,14 @@ func TestLDH_D(t *testing.T) {
     cpu.Registers.D = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDH_D
+    ram(cpu)[0x0100] = instructions.LDH_D
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1254,11 +1419,14 @@ func TestLDH_E(t *testing.T) {
     cpu.Registers.E = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDH_E
+    ram(cpu)[0x0100] = instructions.LDH_E
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1275,11 +1443,14 @@ func TestLDH_H(t *testing.T) {
     cpu.Registers.H = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDH_H
+    ram(cpu)[0x0100] = instructions.LDH_H
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1296,11 +1467,14 @@ func TestLDH_L(t *testing.T) {
     cpu.Registers.L = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDH_L
+    ram(cpu)[0x0100] = instructions.LDH_L
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1318,12 +1492,15 @@ func TestLDH_HL(t *testing.T) {
     cpu.Registers.L = 0x8F
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDH_HL
-    cpu.Memory.RAM[0x808F] = 0x20
+    ram(cpu)[0x0100] = instructions.LDH_HL
+    ram(cpu)[0x808F] = 0x20
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1340,11 +1517,14 @@ func TestLDL_B(t *testing.T) {
     cpu.Registers.B = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDL_B
+    ram(cpu)[0x0100] = instructions.LDL_B
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1361,11 +1541,14 @@ func TestLDL_C(t *testing.T) {
     cpu.Registers.C = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDL_C
+    ram(cpu)[0x0100] = instructions.LDL_C
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1381,11 +1564,14 @@ func TestLDL_D(t *testing.T) {
     cpu.Registers.D = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDL_D
+    ram(cpu)[0x0100] = instructions.LDL_D
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1401,11 +1587,14 @@ func TestLDL_E(t *testing.T) {
     cpu.Registers.E = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDL_E
+    ram(cpu)[0x0100] = instructions.LDL_E
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1421,11 +1610,14 @@ func TestLDL_H(t *testing.T) {
     cpu.Registers.H = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDL_H
+    ram(cpu)[0x0100] = instructions.LDL_H
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1442,11 +1634,14 @@ func TestLDL_L(t *testing.T) {
     cpu.Registers.L = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDL_L
+    ram(cpu)[0x0100] = instructions.LDL_L
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1464,12 +1659,15 @@ func TestLDL_HL(t *testing.T) {
     cpu.Registers.L = 0x8F
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDL_HL
-    cpu.Memory.RAM[0x808F] = 0x20
+    ram(cpu)[0x0100] = instructions.LDL_HL
+    ram(cpu)[0x808F] = 0x20
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1488,18 +1686,21 @@ func TestLDHL_B(t *testing.T) {
     cpu.Registers.B = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_B
+    ram(cpu)[0x0100] = instructions.LDHL_B
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[cpu.HL()] != 0x69 {
-        t.Error("Memory address at  HL register should be 0x69, instead got: ", cpu.Memory.RAM[cpu.HL()])
+    if ram(cpu)[cpu.HL()] != 0x69 {
+        t.Error("Memory address at  HL register should be 0x69, instead got: ", ram(cpu)[cpu.HL()])
     }
 }
 
@@ -1511,18 +1712,21 @@ func TestLDHL_C(t *testing.T) {
     cpu.Registers.C = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_C
+    ram(cpu)[0x0100] = instructions.LDHL_C
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[cpu.HL()] != 0x69 {
-        t.Error("Memory address at  HL register should be 0x69, instead got: ", cpu.Memory.RAM[cpu.HL()])
+    if ram(cpu)[cpu.HL()] != 0x69 {
+        t.Error("Memory address at  HL register should be 0x69, instead got: ", ram(cpu)[cpu.HL()])
     }
 }
 
@@ -1534,18 +1738,21 @@ func TestLDHL_D(t *testing.T) {
     cpu.Registers.D = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_D
+    ram(cpu)[0x0100] = instructions.LDHL_D
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[cpu.HL()] != 0x69 {
-        t.Error("Memory address at  HL register should be 0x69, instead got: ", cpu.Memory.RAM[cpu.HL()])
+    if ram(cpu)[cpu.HL()] != 0x69 {
+        t.Error("Memory address at  HL register should be 0x69, instead got: ", ram(cpu)[cpu.HL()])
     }
 }
 
@@ -1557,18 +1764,21 @@ func TestLDHL_E(t *testing.T) {
     cpu.Registers.E = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_E
+    ram(cpu)[0x0100] = instructions.LDHL_E
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[cpu.HL()] != 0x69 {
-        t.Error("Memory address at  HL register should be 0x69, instead got: ", cpu.Memory.RAM[cpu.HL()])
+    if ram(cpu)[cpu.HL()] != 0x69 {
+        t.Error("Memory address at  HL register should be 0x69, instead got: ", ram(cpu)[cpu.HL()])
     }
 }
 
@@ -1579,18 +1789,21 @@ func TestLDHL_H(t *testing.T) {
     cpu.Registers.L = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_H
+    ram(cpu)[0x0100] = instructions.LDHL_H
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[cpu.HL()] != 0x11 {
-        t.Error("Memory address at  HL register should be 0x11, instead got: ", cpu.Memory.RAM[cpu.HL()])
+    if ram(cpu)[cpu.HL()] != 0x11 {
+        t.Error("Memory address at  HL register should be 0x11, instead got: ", ram(cpu)[cpu.HL()])
     }
 }
 
@@ -1601,18 +1814,21 @@ func TestLDHL_L(t *testing.T) {
     cpu.Registers.L = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_L
+    ram(cpu)[0x0100] = instructions.LDHL_L
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[cpu.HL()] != 0x69 {
-        t.Error("Memory address at  HL register should be 0x69, instead got: ", cpu.Memory.RAM[cpu.HL()])
+    if ram(cpu)[cpu.HL()] != 0x69 {
+        t.Error("Memory address at  HL register should be 0x69, instead got: ", ram(cpu)[cpu.HL()])
     }
 }
 
@@ -1624,18 +1840,21 @@ func TestLDHL_A(t *testing.T) {
     cpu.Registers.A = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_A
+    ram(cpu)[0x0100] = instructions.LDHL_A
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[cpu.HL()] != 0x69 {
-        t.Error("Memory address at  HL register should be 0x69, instead got: ", cpu.Memory.RAM[cpu.HL()])
+    if ram(cpu)[cpu.HL()] != 0x69 {
+        t.Error("Memory address at  HL register should be 0x69, instead got: ", ram(cpu)[cpu.HL()])
     }
 }
 
@@ -1645,11 +1864,14 @@ func TestLDA_B(t *testing.T) {
     cpu.Registers.B = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_B
+    ram(cpu)[0x0100] = instructions.LDA_B
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1666,11 +1888,14 @@ func TestLDA_C(t *testing.T) {
     cpu.Registers.C = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_C
+    ram(cpu)[0x0100] = instructions.LDA_C
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1686,11 +1911,14 @@ func TestLDA_D(t *testing.T) {
     cpu.Registers.D = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_D
+    ram(cpu)[0x0100] = instructions.LDA_D
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1706,11 +1934,14 @@ func TestLDA_E(t *testing.T) {
     cpu.Registers.E = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_E
+    ram(cpu)[0x0100] = instructions.LDA_E
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1726,11 +1957,14 @@ func TestLDA_H(t *testing.T) {
     cpu.Registers.H = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_H
+    ram(cpu)[0x0100] = instructions.LDA_H
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1747,11 +1981,14 @@ func TestLDA_L(t *testing.T) {
     cpu.Registers.L = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_L
+    ram(cpu)[0x0100] = instructions.LDA_L
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1769,12 +2006,15 @@ func TestLDA_HL(t *testing.T) {
     cpu.Registers.L = 0x8F
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_HL
-    cpu.Memory.RAM[0x808F] = 0x20
+    ram(cpu)[0x0100] = instructions.LDA_HL
+    ram(cpu)[0x808F] = 0x20
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1791,11 +2031,14 @@ func TestLDA_A(t *testing.T) {
     cpu.Registers.A = 0x69
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDA_A
+    ram(cpu)[0x0100] = instructions.LDA_A
 
     // When
     expectedCycles := 1
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1812,19 +2055,22 @@ func TestLDa8_A(t *testing.T) {
     cpu.Registers.A = 0x77
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDa8_A
-    cpu.Memory.RAM[0x0101] = 0x22
+    ram(cpu)[0x0100] = instructions.LDa8_A
+    ram(cpu)[0x0101] = 0x22
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[0xFF22] != 0x77 {
-        t.Error("Memory cell at 0xFF22 should be 0x77, instead got: ", cpu.Memory.RAM[0xFF22])
+    if ram(cpu)[0xFF22] != 0x77 {
+        t.Error("Memory cell at 0xFF22 should be 0x77, instead got: ", ram(cpu)[0xFF22])
     }
 }
 
@@ -1833,13 +2079,16 @@ func TestSP_d16(t *testing.T) {
     cpu := InitSM83()
 
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDSP_d16
-    cpu.Memory.RAM[0x0101] = 0x22
-    cpu.Memory.RAM[0x0102] = 0x80
+    ram(cpu)[0x0100] = instructions.LDSP_d16
+    ram(cpu)[0x0101] = 0x22
+    ram(cpu)[0x0102] = 0x80
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1858,42 +2107,48 @@ func TestLDCind_A(t *testing.T) {
     cpu.Registers.C = 0xA2
     
     // Given
-    cpu.Memory.RAM[0x0100] = instructions.LDCind_A
+    ram(cpu)[0x0100] = instructions.LDCind_A
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[0xFFA2] != 0x77 {
-        t.Error("Memory cell at 0xFF22 should be 0x77, instead got: ", cpu.Memory.RAM[0xFF22])
+    if ram(cpu)[0xFFA2] != 0x77 {
+        t.Error("Memory cell at 0xFF22 should be 0x77, instead got: ", ram(cpu)[0xFF22])
     }
 }
 
-func Testa16_A(t *testing.T) {
+func TestLDa16_A(t *testing.T) {
 
     cpu := InitSM83()
     
     // Given
     // A = 0x11
-    cpu.Memory.RAM[0x0100] = instructions.LDa16_A
-    cpu.Memory.RAM[0x0101] = 0x52
-    cpu.Memory.RAM[0x0102] = 0x72
+    ram(cpu)[0x0100] = instructions.LDa16_A
+    ram(cpu)[0x0101] = 0x52
+    ram(cpu)[0x0102] = 0x72
 
     // 0x5555 data into 7252
 
     // When
     expectedCycles := 4
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[0x7252] != 0x11 {
+    if ram(cpu)[0x7252] != 0x11 {
         t.Error("Address 0x7252 should be 0x11.")
     }
 }
@@ -1904,14 +2159,17 @@ func TestA_a8(t *testing.T) {
     
     // Given
     // A = 0x11
-    cpu.Memory.RAM[0x0100] = instructions.LDA_a8
-    cpu.Memory.RAM[0x0101] = 0x52
-    cpu.Memory.RAM[0xFF52] = 0x69
+    ram(cpu)[0x0100] = instructions.LDA_a8
+    ram(cpu)[0x0101] = 0x52
+    ram(cpu)[0xFF52] = 0x69
 
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1929,12 +2187,15 @@ func TestA_Cind(t *testing.T) {
     // Given
     // A = 0x11
     cpu.Registers.C = 0x33
-    cpu.Memory.RAM[0x0100] = instructions.LDA_Cind
-    cpu.Memory.RAM[0xFF33] = 0x69
+    ram(cpu)[0x0100] = instructions.LDA_Cind
+    ram(cpu)[0xFF33] = 0x69
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1951,15 +2212,18 @@ func TestA_a16(t *testing.T) {
     
     // Given
     // A = 0x11
-    cpu.Memory.RAM[0x0100] = instructions.LDA_a16
-    cpu.Memory.RAM[0x0101] = 0x52
-    cpu.Memory.RAM[0x0102] = 0x53
-    cpu.Memory.RAM[0x5352] = 0x69
+    ram(cpu)[0x0100] = instructions.LDA_a16
+    ram(cpu)[0x0101] = 0x52
+    ram(cpu)[0x0102] = 0x53
+    ram(cpu)[0x5352] = 0x69
 
 
     // When
     expectedCycles := 4
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -1978,13 +2242,16 @@ func TestPOP_BC(t *testing.T) {
     // SP = 0xFFFE
     cpu.Registers.C = 0x12
     initialSP := cpu.Registers.SP
-    cpu.Memory.RAM[0x0100] = instructions.POP_BC
-    cpu.Memory.RAM[0xFFFE] = 0x30
-    cpu.Memory.RAM[0xFFFF] = 0x34
+    ram(cpu)[0x0100] = instructions.POP_BC
+    ram(cpu)[0xFFFE] = 0x30
+    ram(cpu)[0xFFFF] = 0x34
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2013,22 +2280,25 @@ func TestPUSH_BC(t *testing.T) {
     cpu.Registers.B = 0xA2
     cpu.Registers.C = 0x12
     initialSP := cpu.Registers.SP
-    cpu.Memory.RAM[0x0100] = instructions.PUSH_BC
+    ram(cpu)[0x0100] = instructions.PUSH_BC
 
     // When
     expectedCycles := 4
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[initialSP - 1] != 0xA2 {
-        t.Error("Contents at SP-1 should be 0xA2, instead got: ", cpu.Memory.RAM[initialSP - 1])
+    if ram(cpu)[initialSP - 1] != 0xA2 {
+        t.Error("Contents at SP-1 should be 0xA2, instead got: ", ram(cpu)[initialSP - 1])
     }
 
-    if cpu.Memory.RAM[initialSP - 2] != 0x12 {
-        t.Error("Contents at SP-2 should be 0x12, instead got: ", cpu.Memory.RAM[initialSP - 2])
+    if ram(cpu)[initialSP - 2] != 0x12 {
+        t.Error("Contents at SP-2 should be 0x12, instead got: ", ram(cpu)[initialSP - 2])
     }
 }
 
@@ -2040,13 +2310,16 @@ func TestPOP_DE(t *testing.T) {
     // SP = 0xFFFE
     cpu.Registers.E = 0x12
     initialSP := cpu.Registers.SP
-    cpu.Memory.RAM[0x0100] = instructions.POP_DE
-    cpu.Memory.RAM[0xFFFE] = 0x30
-    cpu.Memory.RAM[0xFFFF] = 0x34
+    ram(cpu)[0x0100] = instructions.POP_DE
+    ram(cpu)[0xFFFE] = 0x30
+    ram(cpu)[0xFFFF] = 0x34
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2075,22 +2348,25 @@ func TestPUSH_DE(t *testing.T) {
     cpu.Registers.D = 0xA2
     cpu.Registers.E = 0x12
     initialSP := cpu.Registers.SP
-    cpu.Memory.RAM[0x0100] = instructions.PUSH_DE
+    ram(cpu)[0x0100] = instructions.PUSH_DE
 
     // When
     expectedCycles := 4
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[initialSP - 1] != 0xA2 {
-        t.Error("Contents at SP-1 should be 0xA2, instead got: ", cpu.Memory.RAM[initialSP - 1])
+    if ram(cpu)[initialSP - 1] != 0xA2 {
+        t.Error("Contents at SP-1 should be 0xA2, instead got: ", ram(cpu)[initialSP - 1])
     }
 
-    if cpu.Memory.RAM[initialSP - 2] != 0x12 {
-        t.Error("Contents at SP-2 should be 0x12, instead got: ", cpu.Memory.RAM[initialSP - 2])
+    if ram(cpu)[initialSP - 2] != 0x12 {
+        t.Error("Contents at SP-2 should be 0x12, instead got: ", ram(cpu)[initialSP - 2])
     }
 }
 
@@ -2102,13 +2378,16 @@ func TestPOP_HL(t *testing.T) {
     // SP = 0xFFFE
     cpu.Registers.L = 0x12
     initialSP := cpu.Registers.SP
-    cpu.Memory.RAM[0x0100] = instructions.POP_HL
-    cpu.Memory.RAM[0xFFFE] = 0x30
-    cpu.Memory.RAM[0xFFFF] = 0x34
+    ram(cpu)[0x0100] = instructions.POP_HL
+    ram(cpu)[0xFFFE] = 0x30
+    ram(cpu)[0xFFFF] = 0x34
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2137,22 +2416,25 @@ func TestPUSH_HL(t *testing.T) {
     cpu.Registers.H = 0xA2
     cpu.Registers.L = 0x12
     initialSP := cpu.Registers.SP
-    cpu.Memory.RAM[0x0100] = instructions.PUSH_HL
+    ram(cpu)[0x0100] = instructions.PUSH_HL
 
     // When
     expectedCycles := 4
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[initialSP - 1] != 0xA2 {
-        t.Error("Contents at SP-1 should be 0xA2, instead got: ", cpu.Memory.RAM[initialSP - 1])
+    if ram(cpu)[initialSP - 1] != 0xA2 {
+        t.Error("Contents at SP-1 should be 0xA2, instead got: ", ram(cpu)[initialSP - 1])
     }
 
-    if cpu.Memory.RAM[initialSP - 2] != 0x12 {
-        t.Error("Contents at SP-2 should be 0x12, instead got: ", cpu.Memory.RAM[initialSP - 2])
+    if ram(cpu)[initialSP - 2] != 0x12 {
+        t.Error("Contents at SP-2 should be 0x12, instead got: ", ram(cpu)[initialSP - 2])
     }
 }
 
@@ -2164,13 +2446,16 @@ func TestPOP_AF(t *testing.T) {
     // SP = 0xFFFE
     cpu.Registers.F = 0x12
     initialSP := cpu.Registers.SP
-    cpu.Memory.RAM[0x0100] = instructions.POP_AF
-    cpu.Memory.RAM[0xFFFE] = 0x30
-    cpu.Memory.RAM[0xFFFF] = 0x34
+    ram(cpu)[0x0100] = instructions.POP_AF
+    ram(cpu)[0xFFFE] = 0x30
+    ram(cpu)[0xFFFF] = 0x34
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2190,6 +2475,30 @@ func TestPOP_AF(t *testing.T) {
     }
 }
 
+func TestPOP_AFClearsLowNibbleOfF(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.POP_AF
+    ram(cpu)[0xFFFE] = 0xFF
+    ram(cpu)[0xFFFF] = 0xFF
+
+    // When
+    _, err := cpu.Execute(3)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cpu.Registers.F != 0xF0 {
+        t.Error("F register should be 0xF0, instead got: ", cpu.Registers.F)
+    }
+
+    if cpu.Registers.A != 0xFF {
+        t.Error("A register should be 0xFF, instead got: ", cpu.Registers.A)
+    }
+}
+
 func TestPUSH_AF(t *testing.T) {
 
     cpu := InitSM83()
@@ -2199,22 +2508,25 @@ func TestPUSH_AF(t *testing.T) {
     cpu.Registers.A = 0xA2
     cpu.Registers.F = 0x12
     initialSP := cpu.Registers.SP
-    cpu.Memory.RAM[0x0100] = instructions.PUSH_AF
+    ram(cpu)[0x0100] = instructions.PUSH_AF
 
     // When
     expectedCycles := 4
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Memory.RAM[initialSP - 1] != 0xA2 {
-        t.Error("Contents at SP-1 should be 0xA2, instead got: ", cpu.Memory.RAM[initialSP - 1])
+    if ram(cpu)[initialSP - 1] != 0xA2 {
+        t.Error("Contents at SP-1 should be 0xA2, instead got: ", ram(cpu)[initialSP - 1])
     }
 
-    if cpu.Memory.RAM[initialSP - 2] != 0x12 {
-        t.Error("Contents at SP-2 should be 0x12, instead got: ", cpu.Memory.RAM[initialSP - 2])
+    if ram(cpu)[initialSP - 2] != 0x12 {
+        t.Error("Contents at SP-2 should be 0x12, instead got: ", ram(cpu)[initialSP - 2])
     }
 }
 
@@ -2224,13 +2536,16 @@ func TestLDHL_SPs8SetsHalfCarryFlag(t *testing.T) {
 
     // Given
     cpu.Registers.SP = 0x004F
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_SPs8
-    cpu.Memory.RAM[0x0101] = 0x05
+    ram(cpu)[0x0100] = instructions.LDHL_SPs8
+    ram(cpu)[0x0101] = 0x05
 
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2255,12 +2570,15 @@ func TestLDHL_SPs8SetsCarryFlag(t *testing.T) {
 
     // Given
     cpu.Registers.SP = 0x00F4
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_SPs8
-    cpu.Memory.RAM[0x0101] = 0x11
+    ram(cpu)[0x0100] = instructions.LDHL_SPs8
+    ram(cpu)[0x0101] = 0x11
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2285,12 +2603,15 @@ func TestLDHL_SPs8SetsHalfAndCarryFlag(t *testing.T) {
 
     // Given
     cpu.Registers.SP = 0x00FF
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_SPs8
-    cpu.Memory.RAM[0x0101] = 0x11
+    ram(cpu)[0x0100] = instructions.LDHL_SPs8
+    ram(cpu)[0x0101] = 0x11
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2315,8 +2636,8 @@ func TestLDHL_SPs8SetsHalfAndCarryFlagWithNegative8(t *testing.T) {
 
     // Given
     cpu.Registers.SP = 0x00FF
-    cpu.Memory.RAM[0x0100] = instructions.LDHL_SPs8
-    cpu.Memory.RAM[0x0101] = 0b11111011 // (-5)
+    ram(cpu)[0x0100] = instructions.LDHL_SPs8
+    ram(cpu)[0x0101] = 0b11111011 // (-5)
 
     // -5 = 0xFB
     // 0x0F + 0x0B = 0x1A > 0x0F -> sets Half-Carry
@@ -2325,7 +2646,10 @@ func TestLDHL_SPs8SetsHalfAndCarryFlagWithNegative8(t *testing.T) {
 
     // When
     expectedCycles := 3
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
@@ -2349,28 +2673,26 @@ func TestLDSP_HL(t *testing.T) {
     cpu := InitSM83()
 
     // Given
-    // SP = 0xFFFE
-    cpu.Memory.RAM[cpu.HL()] = 0x55
-    cpu.Memory.RAM[0x0100] = instructions.LDSP_HL
-
-    // -5 = 0xFB
-    // 0x0F + 0x0B = 0x1A > 0x0F -> sets Half-Carry
-
-    // 0xFF + 0xFB = 0x01FA > 0xFF -> sets Carry
+    cpu.Registers.H = 0x12
+    cpu.Registers.L = 0x34
+    ram(cpu)[0x0100] = instructions.LDSP_HL
 
     // When
     expectedCycles := 2
-    cyclesUsed := cpu.Execute(expectedCycles)
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     if cyclesUsed != expectedCycles {
         t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
     }
 
-    if cpu.Registers.L != 0xFE {
-        t.Error("L register should be 0xFE, instead got: ", cpu.Registers.L)
+    if cpu.Registers.SP != 0x1234 {
+        t.Error("SP register should be 0x1234, instead got: ", cpu.Registers.SP)
     }
 
-    if cpu.Registers.H != 0xFF {
-        t.Error("H register should be 0x00, instead got: ", cpu.Registers.H)
+    if cpu.HL() != 0x1234 {
+        t.Error("HL register should be left to 0x1234, instead got: ", cpu.HL())
     }
 }
@@ -8,6 +8,8 @@ func TestCPUResetsCorrectly(t *testing.T) {
     cpu := InitSM83()
 
     want := &CPU{
+        Bus: cpu.Bus,
+        cycleStepping: cpu.cycleStepping,
         Registers: RegisterFile{
             PC: 0x0100,
             SP: 0xFFFE,
@@ -33,7 +35,10 @@ func TestCPUDoesNothingWhenExecutingZeroCycles(t *testing.T) {
     cpu := InitSM83()
 
     // When
-    cyclesUsed := cpu.Execute(0)
+    cyclesUsed, err := cpu.Execute(0)
+    if err != nil {
+        t.Fatal(err)
+    }
 
     // Then
     if cyclesUsed != 0 {
@@ -43,8 +48,12 @@ func TestCPUDoesNothingWhenExecutingZeroCycles(t *testing.T) {
 
 
 func InitSM83() (cpu *CPU){
-    cpu = &CPU{}
-    cpu.Memory = Memory{}
-    cpu.ResetCPU()
+    cpu = NewCPU(&Memory{})
+    cpu.cycleStepping = cycleStepping
     return
 }
+
+// ram returns the flat memory the CPU built by InitSM83 is connected to.
+func ram(cpu *CPU) *[MaxMem]byte {
+    return &cpu.Bus.(*Memory).RAM
+}
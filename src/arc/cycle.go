@@ -0,0 +1,131 @@
+package arc
+
+import "iter"
+
+// Every instruction is a sequence of machine cycles, each one spent in tick: the CPU
+// can be advanced one machine cycle at a time with Cycle, pausing between two ticks.
+//
+// The Step run by Cycle is a coroutine: tick yields back to Cycle before starting the
+// next machine cycle, and Cycle resumes it on the following call. Step and Execute
+// finish a Step left in progress by Cycle before starting a new one.
+
+// inFlight is a Step started by Cycle and not finished yet.
+type inFlight struct {
+    next    func() (struct{}, bool)
+    stop    func()
+    yield   func(struct{}) bool
+
+    // Machine cycles completed so far.
+    cycle   int
+
+    // Opcode being executed, see Step. NoOpcode while dispatching an interrupt or
+    // spending a cycle halted, stopped or locked up.
+    opcode  int
+}
+
+// abortStep unwinds a Step in progress when the CPU is reset.
+type abortStep struct{}
+
+// Cycle advances the CPU by exactly one machine cycle.
+// It returns whether the cycle finished a Step, and the opcode executed by the Step,
+// see Step. Nothing happens while the CPU is faulted.
+func (cpu *CPU) Cycle() (done bool, opcode int, err error) {
+
+    if cpu.current == nil {
+
+        if cpu.fault != nil {
+            return false, NoOpcode, cpu.fault
+        }
+        cpu.startCycling()
+    }
+
+    current := cpu.current
+    _, running := current.next()
+    current.cycle++
+
+    if running {
+        return false, current.opcode, nil
+    }
+
+    cpu.current = nil
+    if cpu.fault != nil {
+        err = cpu.fault
+    }
+    return true, current.opcode, err
+}
+
+// MidInstruction reports whether a Step started by Cycle is in progress, the opcode it
+// is executing (NoOpcode if it is not an instruction) and the machine cycles completed.
+func (cpu *CPU) MidInstruction() (opcode int, cycle int, ok bool) {
+    if cpu.current == nil {
+        return NoOpcode, 0, false
+    }
+    return cpu.current.opcode, cpu.current.cycle, true
+}
+
+// startCycling prepares a Step to be run one machine cycle at a time.
+func (cpu *CPU) startCycling() {
+
+    current := &inFlight{opcode: NoOpcode}
+    current.next, current.stop = iter.Pull(func(yield func(struct{}) bool) {
+
+        defer func() {
+            if r := recover(); r != nil {
+                if _, ok := r.(abortStep); !ok {
+                    panic(r)
+                }
+            }
+        }()
+
+        current.yield = yield
+        cycles := 0
+        current.opcode = cpu.step(&cycles)
+    })
+
+    cpu.current = current
+}
+
+// finishCycling runs the rest of the Step in progress, if any.
+// It returns the machine cycles it took, the opcode executed and whether there was one.
+func (cpu *CPU) finishCycling() (cyclesUsed int, opcode int, ok bool) {
+
+    if cpu.current == nil {
+        return 0, NoOpcode, false
+    }
+
+    for {
+        cyclesUsed++
+        if done, opcode, _ := cpu.Cycle(); done {
+            return cyclesUsed, opcode, true
+        }
+    }
+}
+
+// Close abandons the Step left in progress by Cycle, if any. A Step run by Cycle is a
+// coroutine, on a goroutine of its own until it is finished: call Close before dropping a
+// CPU advanced by Cycle, or the goroutine leaks. The instruction in progress is left halfway,
+// call ResetCPU before executing again.
+func (cpu *CPU) Close() {
+    cpu.stopCycling()
+}
+
+// stopCycling abandons the Step in progress, if any.
+func (cpu *CPU) stopCycling() {
+    if cpu.current != nil {
+        cpu.current.stop()
+        cpu.current = nil
+    }
+}
+
+// pause ends the current machine cycle of a Step run by Cycle, and returns when Cycle
+// is called again. It does nothing for Steps run directly.
+func (cpu *CPU) pause() {
+
+    if cpu.current == nil || cpu.current.yield == nil {
+        return
+    }
+
+    if !cpu.current.yield(struct{}{}) {
+        panic(abortStep{})
+    }
+}
@@ -0,0 +1,188 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"flag"
+	"os"
+	"runtime"
+	"testing"
+)
+
+// cycleStepping is set for the second run of the tests, see TestMain.
+var cycleStepping bool
+
+// TestMain runs every test twice: the second time, CPUs built by InitSM83 run their
+// instructions one machine cycle at a time through Cycle.
+func TestMain(m *testing.M) {
+
+    code := m.Run()
+
+    // Benchmarks do not use InitSM83, there is no point in running them again.
+    if code == 0 && flag.Lookup("test.bench").Value.String() == "" {
+        cycleStepping = true
+        code = m.Run()
+    }
+    os.Exit(code)
+}
+
+func TestCycleAdvancesOneMachineCycle(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.CALL_a16
+    bus.RAM[0x0101] = 0x00
+    bus.RAM[0x0102] = 0x20
+
+    // When, then
+    for cycle := 1; cycle <= 6; cycle++ {
+
+        done, opcode, err := cpu.Cycle()
+        if err != nil {
+            t.Fatal(err)
+        }
+
+        if bus.cycle != cycle {
+            t.Error("Cycle ", cycle, ": bus ticked ", bus.cycle, " cycles")
+        }
+        if opcode != instructions.CALL_a16 {
+            t.Error("Cycle ", cycle, ": opcode should be CALL a16, got ", opcode)
+        }
+        if done != (cycle == 6) {
+            t.Error("Cycle ", cycle, ": done is ", done)
+        }
+    }
+
+    if cpu.Registers.PC != 0x2000 {
+        t.Error("PC should be 0x2000, got ", cpu.Registers.PC)
+    }
+}
+
+func TestMidInstructionState(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.CALL_a16
+    bus.RAM[0x0101] = 0x00
+    bus.RAM[0x0102] = 0x20
+
+    if _, _, ok := cpu.MidInstruction(); ok {
+        t.Error("No instruction should be in progress before Cycle")
+    }
+
+    // When, in the middle of CALL: opcode, R(lsb), R(msb), internal.
+    for i := 0; i < 4; i++ {
+        cpu.Cycle()
+    }
+
+    // Then, nothing has been pushed yet.
+    opcode, cycle, ok := cpu.MidInstruction()
+    if !ok || opcode != instructions.CALL_a16 || cycle != 4 {
+        t.Error("Should be on cycle 4 of CALL a16, got opcode ", opcode, " cycle ", cycle, " in progress ", ok)
+    }
+    if bus.RAM[0xFFFD] != 0x00 || cpu.Registers.PC != 0x0103 {
+        t.Error("CALL should not have pushed PC yet, PC: ", cpu.Registers.PC)
+    }
+}
+
+func TestStepFinishesInstructionInProgress(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.PUSH_BC
+    bus.RAM[0x0101] = instructions.INC_A
+    cpu.Cycle()
+
+    // When
+    cyclesUsed, opcode, err := cpu.Step()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if cyclesUsed != 3 || opcode != instructions.PUSH_BC {
+        t.Error("Step should finish PUSH BC in 3 cycles, got opcode ", opcode, " in ", cyclesUsed)
+    }
+    if _, _, ok := cpu.MidInstruction(); ok {
+        t.Error("No instruction should be in progress after Step")
+    }
+    if cpu.Registers.PC != 0x0101 {
+        t.Error("PC should be 0x0101, got ", cpu.Registers.PC)
+    }
+}
+
+func TestResetAbandonsInstructionInProgress(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.PUSH_BC
+    cpu.Registers.B = 0x12
+    cpu.Cycle()
+    cpu.Cycle()
+
+    // When
+    cpu.ResetCPU()
+
+    // Then, the writes of PUSH never happen.
+    if _, _, ok := cpu.MidInstruction(); ok {
+        t.Error("No instruction should be in progress after ResetCPU")
+    }
+    if bus.RAM[0xFFFD] != 0x00 {
+        t.Error("PUSH BC should have been abandoned, but wrote ", bus.RAM[0xFFFD])
+    }
+    if bus.cycle != 2 {
+        t.Error("Bus should have been ticked 2 cycles, got ", bus.cycle)
+    }
+}
+
+// TestCloseReleasesInstructionInProgress verifies that Close stops the goroutine of the Step
+// started by Cycle.
+func TestCloseReleasesInstructionInProgress(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.CALL_a16
+    goroutines := runtime.NumGoroutine()
+    cpu.Cycle()
+
+    if runtime.NumGoroutine() <= goroutines {
+        t.Fatal("The Step in progress should run on its own goroutine")
+    }
+
+    // When
+    cpu.Close()
+
+    // Then
+    if _, _, ok := cpu.MidInstruction(); ok {
+        t.Error("No instruction should be in progress after Close")
+    }
+    if runtime.NumGoroutine() != goroutines {
+        t.Error("Goroutines should be back to ", goroutines, ", got ", runtime.NumGoroutine())
+    }
+}
+
+func TestCycleInterruptDispatch(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    cpu.IME = true
+    bus.RAM[IEAddress] = 1 << Timer
+    bus.RAM[IFAddress] = 1 << Timer
+
+    // When
+    cycles := 0
+    for {
+        cycles++
+        done, opcode, _ := cpu.Cycle()
+        if opcode != NoOpcode {
+            t.Error("Dispatch should report no opcode, got ", opcode)
+        }
+        if done {
+            break
+        }
+    }
+
+    // Then
+    if cycles != 5 || cpu.Registers.PC != Timer.Vector() {
+        t.Error("Dispatch should take 5 cycles and jump to the timer vector, got ", cycles, " cycles, PC ", cpu.Registers.PC)
+    }
+}
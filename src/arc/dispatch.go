@@ -0,0 +1,18 @@
+package arc
+
+//go:generate go run ./gen
+
+// Instructions are dispatched through two tables of 256 handlers, one per opcode, generated
+// into handlers.go from the opcode encoding instead of being written one by one:
+//
+//    0bxxyyyzzz, with y = 0bppq
+//
+// 8-bit operands are encoded in 3 bits, as B, C, D, E, H, L, (HL), A (see instructions.CB_B),
+// 16-bit operands in 2 bits, as BC, DE, HL, SP (AF for PUSH and POP), conditions in 2 bits,
+// as NZ, Z, NC, C. The handlers of every encoding of an operation call the same
+// implementation, e.g. ADD A, r and ADD A, n8 all call Add8.
+//
+// Run "go generate" after changing the generator in gen/.
+
+// handler executes an instruction, its opcode (and prefix) already fetched.
+type handler func(cpu *CPU, cycles *int)
@@ -0,0 +1,72 @@
+package arc
+
+import "fmt"
+
+// FaultKind tells why the CPU could not go on executing instructions.
+type FaultKind byte
+
+const (
+    IllegalOpcode   FaultKind = iota + 1 // The opcode is not part of the instruction set, the CPU locks up.
+    LockedUp                             // The CPU is still locked up, only a reset gets it going again.
+    InvalidAccess                        // A memory access hit an address unmapped on the bus, see StrictBus.
+)
+
+func (k FaultKind) String() string {
+    switch k {
+    case IllegalOpcode:
+        return "illegal opcode"
+    case LockedUp:
+        return "locked up"
+    case InvalidAccess:
+        return "invalid access"
+    default:
+        return "unknown fault"
+    }
+}
+
+// Fault is the error returned by Execute when the CPU stops on a fault.
+// The CPU keeps the fault until ClearFault or ResetCPU is called.
+type Fault struct {
+    Kind    FaultKind
+
+    // PC is the address of the faulting instruction, Opcode its first byte (0xCB for prefixed ones).
+    PC      uint16
+    Opcode  byte
+
+    // Address is the accessed address, for InvalidAccess faults.
+    Address uint16
+}
+
+func (f *Fault) Error() string {
+    if f.Kind == InvalidAccess {
+        return fmt.Sprintf("%s to 0x%04X by opcode 0x%02X at PC 0x%04X", f.Kind, f.Address, f.Opcode, f.PC)
+    }
+    return fmt.Sprintf("%s: opcode 0x%02X at PC 0x%04X", f.Kind, f.Opcode, f.PC)
+}
+
+// Fault returns the fault the CPU is stopped on, nil if it is running.
+func (cpu *CPU) Fault() *Fault {
+    return cpu.fault
+}
+
+// ClearFault clears the current fault, so that the next Execute resumes from the current PC.
+// A CPU locked up by an illegal opcode stays locked up until ResetCPU: it keeps consuming
+// cycles without executing, and faults again with LockedUp.
+func (cpu *CPU) ClearFault() {
+    cpu.fault = nil
+}
+
+// RaiseFault stops the CPU at the end of the current instruction.
+// Only the first fault raised is kept.
+func (cpu *CPU) RaiseFault(kind FaultKind, address uint16) {
+    if cpu.fault != nil {
+        return
+    }
+
+    cpu.fault = &Fault{
+        Kind:       kind,
+        PC:         cpu.instructionPC,
+        Opcode:     cpu.opcode,
+        Address:    address,
+    }
+}
@@ -0,0 +1,274 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"errors"
+	"testing"
+)
+
+// TestIllegalOpcodeLocksUp verifies that an illegal opcode locks up the CPU and Execute returns a typed error.
+func TestIllegalOpcodeLocksUp(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.INC_A
+    ram(cpu)[0x0101] = instructions.ILLEGAL_DD
+
+    // When
+    cyclesUsed, err := cpu.Execute(10)
+
+    var fault *Fault
+    if !errors.As(err, &fault) {
+        t.Fatal("Execute should return a *Fault, instead got: ", err)
+    }
+
+    if fault.Kind != IllegalOpcode {
+        t.Error("Fault kind should be illegal opcode, instead got: ", fault.Kind)
+    }
+
+    if fault.PC != 0x0101 {
+        t.Error("Fault PC should be 0x0101, instead got: ", fault.PC)
+    }
+
+    if fault.Opcode != instructions.ILLEGAL_DD {
+        t.Error("Fault opcode should be 0xDD, instead got: ", fault.Opcode)
+    }
+
+    // Time keeps passing while locked up.
+    if cyclesUsed != 10 {
+        t.Error("The whole budget should be spent locked up, cycles used: ", cyclesUsed)
+    }
+}
+
+// TestFaultIsSticky verifies that a faulted CPU does not execute until the fault is cleared,
+// and that a locked up CPU stays locked up after that.
+func TestFaultIsSticky(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = true
+    ram(cpu)[IEAddress] = 1 << VBlank
+    ram(cpu)[0x0100] = instructions.ILLEGAL_FC
+    ram(cpu)[0x0101] = instructions.INC_A
+    initialA := cpu.Registers.A
+
+    cpu.Execute(1)
+
+    // When
+    cyclesUsed, err := cpu.Execute(1)
+
+    if err == nil || cyclesUsed != 0 {
+        t.Error("A faulted CPU should not execute, cycles used: ", cyclesUsed, " err: ", err)
+    }
+
+    if cpu.Fault() == nil {
+        t.Error("Fault should be kept.")
+    }
+
+    // Interrupts can't wake up a locked up CPU.
+    cpu.RequestInterrupt(VBlank)
+    cpu.ClearFault()
+    cyclesUsed, err = cpu.Execute(20)
+
+    var fault *Fault
+    if !errors.As(err, &fault) || fault.Kind != LockedUp {
+        t.Fatal("Execute should return a locked up fault, instead got: ", err)
+    }
+
+    if fault.PC != 0x0100 || fault.Opcode != instructions.ILLEGAL_FC {
+        t.Error("Fault should report the illegal opcode at 0x0100, instead got: ", fault)
+    }
+
+    if cyclesUsed != 20 {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", 20)
+    }
+
+    if cpu.Registers.A != initialA || cpu.Registers.PC != 0x0101 {
+        t.Error("A locked up CPU should not execute anything.")
+    }
+}
+
+// TestResetClearsFault verifies that a faulted CPU can be reset.
+func TestResetClearsFault(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.ILLEGAL_D3
+    cpu.Execute(1)
+
+    // When
+    cpu.ResetCPU()
+
+    if cpu.Fault() != nil {
+        t.Error("ResetCPU should clear the fault.")
+    }
+
+    if cpu.Registers.PC != 0x0100 {
+        t.Error("PC should be 0x0100, instead got: ", cpu.Registers.PC)
+    }
+
+    ram(cpu)[0x0100] = instructions.NOP
+    cyclesUsed, err := cpu.Execute(1)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != 1 || cpu.Registers.PC != 0x0101 {
+        t.Error("CPU should execute again after a reset.")
+    }
+}
+
+// strictMemory is a flat memory leaving 0xFEA0-0xFEFF unmapped, as on CGB.
+type strictMemory struct {
+    Memory
+}
+
+func (m *strictMemory) Mapped(address uint16) bool {
+    return address < 0xFEA0 || address > 0xFEFF
+}
+
+// TestUnmappedWriteFaults verifies that a write to an address the bus reports unmapped faults
+// the CPU at the end of the instruction, with and without the block cache.
+func TestUnmappedWriteFaults(t *testing.T) {
+
+    for _, cached := range []bool{false, true} {
+
+        cpu := NewCPU(&strictMemory{})
+        cpu.SetBlockCache(cached)
+        memory := &cpu.Bus.(*strictMemory).RAM
+
+        // Given
+        cpu.Registers.A = 0x42
+        memory[0x0100] = instructions.LDa16_A
+        memory[0x0101] = 0xA0
+        memory[0x0102] = 0xFE
+        memory[0x0103] = instructions.INC_A
+
+        // When
+        cyclesUsed, err := cpu.Execute(10)
+
+        // Then
+        var fault *Fault
+        if !errors.As(err, &fault) {
+            t.Fatal("Execute should return a *Fault, instead got: ", err)
+        }
+
+        if fault.Kind != InvalidAccess || fault.Address != 0xFEA0 {
+            t.Error("Fault should be an invalid access to 0xFEA0, instead got: ", fault)
+        }
+
+        if fault.PC != 0x0100 || fault.Opcode != instructions.LDa16_A {
+            t.Error("Fault should report LD (a16), A at 0x0100, instead got: ", fault)
+        }
+
+        if cyclesUsed != 4 || memory[0xFEA0] != 0 {
+            t.Error("The write should be dropped at the end of the instruction, cycles used: ", cyclesUsed)
+        }
+
+        // When: the CPU resumes after the faulting instruction.
+        cpu.ClearFault()
+        if _, err := cpu.Execute(1); err != nil {
+            t.Fatal(err)
+        }
+
+        // Then
+        if cpu.Registers.A != 0x43 {
+            t.Error("INC A should be executed after the fault is cleared, A: ", cpu.Registers.A)
+        }
+    }
+}
+
+// TestFaultKeepsCycleDebt verifies that the cycles a faulting instruction overshoots are owed
+// to the next call, as for any other instruction.
+func TestFaultKeepsCycleDebt(t *testing.T) {
+
+    cpu := NewCPU(&strictMemory{})
+    memory := &cpu.Bus.(*strictMemory).RAM
+
+    // Given
+    memory[0x0100] = instructions.LDa16_A
+    memory[0x0101] = 0xA0
+    memory[0x0102] = 0xFE
+    memory[0x0103] = instructions.INC_A
+    initialA := cpu.Registers.A
+
+    // When: LD (a16), A takes 4 cycles.
+    cyclesUsed, err := cpu.Execute(1)
+
+    // Then
+    if err == nil || cyclesUsed != 4 {
+        t.Fatal("The write should fault after 4 cycles, cycles used: ", cyclesUsed, " err: ", err)
+    }
+
+    // When: the 3 cycles overshot are not executed again.
+    cpu.ClearFault()
+    cyclesUsed, err = cpu.Execute(3)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if cyclesUsed != 0 || cpu.Registers.A != initialA {
+        t.Error("No instruction should be executed, cycles used: ", cyclesUsed)
+    }
+
+    // When
+    cyclesUsed, err = cpu.Execute(1)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if cyclesUsed != 1 || cpu.Registers.A != initialA + 1 {
+        t.Error("INC A should be executed, cycles used: ", cyclesUsed)
+    }
+}
+
+// TestUnmappedFetchFaults verifies that jumping to an unmapped address faults on the opcode fetch.
+func TestUnmappedFetchFaults(t *testing.T) {
+
+    cpu := NewCPU(&strictMemory{})
+    memory := &cpu.Bus.(*strictMemory).RAM
+
+    // Given
+    memory[0x0100] = instructions.JP_a16
+    memory[0x0101] = 0xF0
+    memory[0x0102] = 0xFE
+
+    // When
+    _, err := cpu.Execute(10)
+
+    // Then
+    var fault *Fault
+    if !errors.As(err, &fault) {
+        t.Fatal("Execute should return a *Fault, instead got: ", err)
+    }
+
+    if fault.Kind != InvalidAccess || fault.Address != 0xFEF0 || fault.PC != 0xFEF0 {
+        t.Error("Fault should be an invalid access to 0xFEF0, instead got: ", fault)
+    }
+}
+
+func TestFaultError(t *testing.T) {
+
+    fault := &Fault{Kind: IllegalOpcode, PC: 0x1234, Opcode: 0xED}
+
+    if fault.Error() != "illegal opcode: opcode 0xED at PC 0x1234" {
+        t.Error("Unexpected error message: ", fault.Error())
+    }
+
+    fault = &Fault{Kind: LockedUp, PC: 0x1234, Opcode: 0xED}
+
+    if fault.Error() != "locked up: opcode 0xED at PC 0x1234" {
+        t.Error("Unexpected error message: ", fault.Error())
+    }
+
+    fault = &Fault{Kind: InvalidAccess, PC: 0x0150, Opcode: 0xEA, Address: 0xFEA0}
+
+    if fault.Error() != "invalid access to 0xFEA0 by opcode 0xEA at PC 0x0150" {
+        t.Error("Unexpected error message: ", fault.Error())
+    }
+}
@@ -1,25 +1,32 @@
 package arc
 
-import "log"
-
 // FetchByte reads the next byte pointed by PC, increases PC and consumes one cycle.
 // It returns the byte read.
 func (cpu *CPU) FetchByte(cycles *int) byte {
 
-    // Exceeding max memory halts the cpu (Fatal log)
-    if cpu.Registers.PC > MaxMem-1 {
-        log.Fatalf("Program Counter exceeded max memory.")
+    // The read happens at the end of the machine cycle.
+    cpu.tick(cycles)
+
+    // Instructions replayed from the block cache are already decoded, see blocks.go.
+    if cpu.prefetched > 0 {
+        byteRead := byte(cpu.operands)
+        cpu.operands >>= 8
+        cpu.prefetched--
+        cpu.Registers.PC++
+        return byteRead
+    }
+
+    // Fetching from an unmapped address faults the cpu, reading open bus.
+    if !cpu.checkAccess(cpu.Registers.PC) {
+        return 0xFF
     }
 
     // Fetch instruction at Program Counter address.
-    byteRead := cpu.Memory.RAM[cpu.Registers.PC]
+    byteRead := cpu.Bus.Read(cpu.Registers.PC)
 
     // Increment Program Counter.
     cpu.Registers.PC++
 
-    // Consume one clock cycle.
-    *cycles--
-
     return byteRead
 }
 
@@ -27,20 +34,9 @@ func (cpu *CPU) FetchByte(cycles *int) byte {
 // It returns the byte read.
 func (cpu *CPU) FetchWord(cycles *int) uint16 {
 
-    // Exceeding max memory halts the cpu (Fatal log)
-    if cpu.Registers.PC > MaxMem-1 {
-        log.Fatalf("Program Counter exceeded max memory.")
-    }
-
-    // Read LSB
-    lsb := cpu.Memory.RAM[cpu.Registers.PC]
-    cpu.Registers.PC++ 
-    *cycles--
-
-    // Read MSB
-    msb := cpu.Memory.RAM[cpu.Registers.PC]
-    cpu.Registers.PC++
-    *cycles--
+    // Read LSB, then MSB, one machine cycle each.
+    lsb := cpu.FetchByte(cycles)
+    msb := cpu.FetchByte(cycles)
 
     // Compose unsigned 16 bit word
     word := uint16(msb) << 8 | uint16(lsb)
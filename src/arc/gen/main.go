@@ -0,0 +1,353 @@
+// Gen writes handlers.go, the dispatch tables of the CPU, from the opcode encoding.
+//
+// Every opcode gets its own handler function, with its operands spelled out, so that
+// executing an instruction costs one indirect call and no decoding at run time.
+//
+// Run it with "go generate" from the arc package.
+package main
+
+import (
+	"bytes"
+	"cgbemu/src/instructions"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+func main() {
+
+    src, err := generate()
+    if err != nil {
+        log.Fatalln("Formatting generated source: ", err)
+    }
+
+    if err := os.WriteFile("handlers.go", src, 0644); err != nil {
+        log.Fatalln("Writing handlers.go: ", err)
+    }
+}
+
+// generate returns the formatted source of handlers.go.
+func generate() ([]byte, error) {
+
+    var b bytes.Buffer
+
+    fmt.Fprintln(&b, "// Code generated by go run ./gen; DO NOT EDIT.")
+    fmt.Fprintln(&b)
+    fmt.Fprintln(&b, "package arc")
+    fmt.Fprintln(&b)
+    fmt.Fprintln(&b, `import "cgbemu/src/alu"`)
+    fmt.Fprintln(&b)
+
+    writeTable(&b, "unprefixedHandlers", "unprefixed opcodes.", "exec", instructions.Unprefixed, unprefixedBody)
+    writeTable(&b, "cbHandlers", "CB-prefixed opcodes, following PREFIX_CB.", "execCB_", instructions.CBPrefixed, cbBody)
+
+    return format.Source(b.Bytes())
+}
+
+// writeTable writes one handler function per opcode of table, and the table of handlers.
+func writeTable(b *bytes.Buffer, name, doc, prefix string, table [256]instructions.Opcode, body func(op byte) []string) {
+
+    fmt.Fprintf(b, "// %s executes the %s\n", name, doc)
+    fmt.Fprintf(b, "var %s = [256]handler{\n", name)
+    for op, opcode := range table {
+        fmt.Fprintf(b, "\t0x%02X: %s%s,\n", op, prefix, opcode.Name)
+    }
+    fmt.Fprintln(b, "}")
+    fmt.Fprintln(b)
+
+    for op, opcode := range table {
+
+        fmt.Fprintf(b, "// %s%s executes %s.\n", prefix, opcode.Name, opcode)
+        fmt.Fprintf(b, "func %s%s(cpu *CPU, cycles *int) {\n", prefix, opcode.Name)
+        for _, line := range body(byte(op)) {
+            fmt.Fprintf(b, "\t%s\n", line)
+        }
+        fmt.Fprintln(b, "}")
+        fmt.Fprintln(b)
+    }
+}
+
+// Operands are encoded as:
+//
+//    0bxxyyyzzz, with y = 0bppq
+//
+// 8-bit operands in 3 bits, 16-bit register pairs in 2 bits (AF instead of SP for PUSH
+// and POP), conditions in 2 bits.
+var (
+    registers       = [8]string{"B", "C", "D", "E", "H", "L", "(HL)", "A"}
+    pairs           = [4][2]string{{"B", "C"}, {"D", "E"}, {"H", "L"}, {"SP", ""}}
+    stackPairs      = [4][2]string{{"B", "C"}, {"D", "E"}, {"H", "L"}, {"A", "F"}}
+    conditions      = [4]string{"!cpu.IsZflagSet()", "cpu.IsZflagSet()", "!cpu.IsCflagSet()", "cpu.IsCflagSet()"}
+
+    // 8-bit operations on A, 0b10yyyzzz and 0b11yyy110.
+    aluOperations   = [8]string{"Add8", "AddWithCarry8", "Sub8", "SubWithCarry8", "And8", "Xor8", "Or8", "Compare8"}
+
+    // Operations on A and the flags, 0b00yyy111.
+    accumulatorOperations = [8]string{"RLCA", "RRCA", "RLA", "RRA", "DAA", "CPL", "SCF", "CCF"}
+
+    // CB rotates and shifts, 0b00yyyzzz. %s is the operand.
+    shiftOperations = [8]string{
+        "alu.RLC(%s)",
+        "alu.RRC(%s)",
+        "alu.RL(%s, cpu.Registers.F)",
+        "alu.RR(%s, cpu.Registers.F)",
+        "alu.SLA(%s)",
+        "alu.SRA(%s)",
+        "alu.SWAP(%s)",
+        "alu.SRL(%s)",
+    }
+)
+
+const indHL = 6
+
+// read returns the expression reading the 8-bit operand r.
+func read(r byte) string {
+    if r == indHL {
+        return "cpu.ReadByteFromMemory(cycles, cpu.HL())"
+    }
+    return "cpu.Registers." + registers[r]
+}
+
+// write returns the statement writing value into the 8-bit operand r.
+func write(r byte, value string) string {
+    if r == indHL {
+        return "cpu.WriteByteToMemory(cycles, cpu.HL(), " + value + ")"
+    }
+    return "cpu.Registers." + registers[r] + " = " + value
+}
+
+// update returns the statements replacing the 8-bit operand r with operation applied to it.
+// operation is a format with one %s for the operand value.
+func update(r byte, operation string) []string {
+    if r == indHL {
+        return []string{
+            "value := " + read(r),
+            write(r, fmt.Sprintf(operation, "value")),
+        }
+    }
+    return []string{write(r, fmt.Sprintf(operation, read(r)))}
+}
+
+// pair returns the expression reading the register pair p.
+func pair(p byte) string {
+    if p == 3 {
+        return "cpu.Registers.SP"
+    }
+    return "cpu." + pairs[p][0] + pairs[p][1] + "()"
+}
+
+// setPair returns the statement writing the 16-bit value into the register pair p.
+func setPair(p byte, value string) string {
+    if p == 3 {
+        return "cpu.Registers.SP = " + value
+    }
+    return fmt.Sprintf("cpu.Registers.%s, cpu.Registers.%s = byte(%s >> 8), byte(%s)", pairs[p][0], pairs[p][1], value, value)
+}
+
+// stepPair returns the statement incrementing or decrementing the register pair p.
+func stepPair(p byte, function string) string {
+    if p == 3 {
+        return map[string]string{"Increment16Address": "cpu.Registers.SP++", "Decrement16Address": "cpu.Registers.SP--"}[function]
+    }
+    return fmt.Sprintf("%s(&cpu.Registers.%s, &cpu.Registers.%s)", function, pairs[p][1], pairs[p][0])
+}
+
+// unprefixedBody returns the statements executing an unprefixed opcode.
+func unprefixedBody(op byte) []string {
+
+    if instructions.Unprefixed[op].Illegal {
+        return []string{"cpu.LockUp()"}
+    }
+
+    x := op >> 6
+    y := (op >> 3) & 0x07
+    z := op & 0x07
+
+    switch x {
+    case 1:
+        if op == instructions.HALT {
+            return []string{"cpu.Halt()"}
+        }
+        if y == z {
+            return []string{"// Loading a register into itself does nothing."}
+        }
+        return []string{write(y, read(z))}
+    case 2:
+        return []string{fmt.Sprintf("cpu.%s(%s)", aluOperations[y], read(z))}
+    case 0:
+        return block0Body(y, z)
+    default:
+        return block3Body(y, z)
+    }
+}
+
+// block0Body returns the statements executing an opcode in 0x00-0x3F.
+func block0Body(y, z byte) []string {
+
+    p := y >> 1
+    q := y & 0x01
+
+    switch z {
+    case 0:
+        switch y {
+        case 0:
+            return nil
+        case 1:
+            return []string{"cpu.LoadAddressSP(cycles)"}
+        case 2:
+            return []string{"cpu.Stop()"}
+        case 3:
+            return []string{"cpu.JumpRelative(cycles, true)"}
+        default:
+            return []string{"cpu.JumpRelative(cycles, " + conditions[y - 4] + ")"}
+        }
+    case 1:
+        if q == 0 {
+            return []string{"nn := cpu.FetchWord(cycles)", setPair(p, "nn")}
+        }
+        return []string{"cpu.AddHL(cycles, " + pair(p) + ")"}
+    case 2:
+        // (BC), (DE), (HL+), (HL-).
+        address := pair(p)
+        if p == 3 {
+            address = pair(2)
+        }
+
+        var lines []string
+        if q == 0 {
+            lines = []string{"cpu.WriteByteToMemory(cycles, " + address + ", cpu.Registers.A)"}
+        } else {
+            lines = []string{"cpu.Registers.A = cpu.ReadByteFromMemory(cycles, " + address + ")"}
+        }
+
+        switch p {
+        case 2:
+            lines = append(lines, stepPair(2, "Increment16Address"))
+        case 3:
+            lines = append(lines, stepPair(2, "Decrement16Address"))
+        }
+        return lines
+    case 3:
+        // The 16-bit increment takes one internal cycle.
+        if q == 0 {
+            return []string{stepPair(p, "Increment16Address"), "cpu.tick(cycles)"}
+        }
+        return []string{stepPair(p, "Decrement16Address"), "cpu.tick(cycles)"}
+    case 4:
+        return update(y, "cpu.Increment8(%s)")
+    case 5:
+        return update(y, "cpu.Decrement8(%s)")
+    case 6:
+        return []string{write(y, "cpu.FetchByte(cycles)")}
+    default:
+        return []string{"cpu." + accumulatorOperations[y] + "()"}
+    }
+}
+
+// block3Body returns the statements executing a legal opcode in 0xC0-0xFF.
+func block3Body(y, z byte) []string {
+
+    p := y >> 1
+    q := y & 0x01
+
+    switch z {
+    case 0:
+        switch y {
+        case 4:
+            return []string{"cpu.WriteByteToMemory(cycles, 0xFF00|uint16(cpu.FetchByte(cycles)), cpu.Registers.A)"}
+        case 5:
+            return []string{"cpu.AddSPOffset(cycles)"}
+        case 6:
+            return []string{"cpu.Registers.A = cpu.ReadByteFromMemory(cycles, 0xFF00|uint16(cpu.FetchByte(cycles)))"}
+        case 7:
+            return []string{"cpu.LoadHLSPOffset(cycles)"}
+        default:
+            return []string{"cpu.ReturnIf(cycles, " + conditions[y] + ")"}
+        }
+    case 1:
+        if q == 0 {
+
+            // The low nibble of F is always zero.
+            lsb := "lsb"
+            if p == 3 {
+                lsb = "lsb & 0xF0"
+            }
+            return []string{
+                "lsb := cpu.PopFromSP(cycles)",
+                "msb := cpu.PopFromSP(cycles)",
+                fmt.Sprintf("cpu.Registers.%s, cpu.Registers.%s = msb, %s", stackPairs[p][0], stackPairs[p][1], lsb),
+            }
+        }
+        switch p {
+        case 0:
+            return []string{"cpu.Return(cycles)"}
+        case 1:
+            return []string{"cpu.ReturnFromInterrupt(cycles)"}
+        case 2:
+            return []string{"cpu.Registers.PC = cpu.HL()"}
+        default:
+            return []string{"cpu.LoadSPHL(cycles)"}
+        }
+    case 2:
+        switch y {
+        case 4:
+            return []string{"cpu.WriteByteToMemory(cycles, 0xFF00|uint16(cpu.Registers.C), cpu.Registers.A)"}
+        case 5:
+            return []string{"cpu.WriteByteToMemory(cycles, cpu.FetchWord(cycles), cpu.Registers.A)"}
+        case 6:
+            return []string{"cpu.Registers.A = cpu.ReadByteFromMemory(cycles, 0xFF00|uint16(cpu.Registers.C))"}
+        case 7:
+            return []string{"cpu.Registers.A = cpu.ReadByteFromMemory(cycles, cpu.FetchWord(cycles))"}
+        default:
+            return []string{"cpu.JumpAbsolute(cycles, " + conditions[y] + ")"}
+        }
+    case 3:
+        switch y {
+        case 0:
+            return []string{"cpu.JumpAbsolute(cycles, true)"}
+        case 1:
+            return []string{"cpu.ExecuteCB(cycles)"}
+        case 6:
+            return []string{"cpu.DisableInterrupts()"}
+        default:
+            return []string{"cpu.EnableInterrupts()"}
+        }
+    case 4:
+        return []string{"cpu.Call(cycles, " + conditions[y] + ")"}
+    case 5:
+        if q == 0 {
+            return []string{fmt.Sprintf("cpu.Push(cycles, cpu.Registers.%s, cpu.Registers.%s)", stackPairs[p][0], stackPairs[p][1])}
+        }
+        return []string{"cpu.Call(cycles, true)"}
+    case 6:
+        return []string{"cpu." + aluOperations[y] + "(cpu.FetchByte(cycles))"}
+    default:
+        return []string{fmt.Sprintf("cpu.Restart(cycles, 0x%02X)", y * 8)}
+    }
+}
+
+// cbBody returns the statements executing a CB opcode.
+func cbBody(op byte) []string {
+
+    y := (op >> 3) & 0x07
+    z := op & 0x07
+
+    switch op & 0xC0 {
+    case instructions.CB_BIT:
+        return []string{fmt.Sprintf("cpu.Registers.F = alu.Bit(%d, %s, cpu.Registers.F)", y, read(z))}
+    case instructions.CB_RES:
+        return update(z, fmt.Sprintf("%%s &^ (1 << %d)", y))
+    case instructions.CB_SET:
+        return update(z, fmt.Sprintf("%%s | (1 << %d)", y))
+    }
+
+    if z == indHL {
+        return []string{
+            "result, flags := " + fmt.Sprintf(shiftOperations[y], read(z)),
+            write(z, "result"),
+            "cpu.Registers.F = flags",
+        }
+    }
+    return []string{fmt.Sprintf("%s, cpu.Registers.F = %s", read(z), fmt.Sprintf(shiftOperations[y], read(z)))}
+}
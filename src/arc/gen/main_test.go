@@ -0,0 +1,24 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestHandlersAreUpToDate(t *testing.T) {
+
+    want, err := generate()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    got, err := os.ReadFile("../handlers.go")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if !bytes.Equal(got, want) {
+        t.Error("handlers.go is out of date, run go generate in src/arc.")
+    }
+}
@@ -0,0 +1,256 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"testing"
+)
+
+// TestHALTConsumesCyclesWhileHalted verifies that the cycle budget is used up while sleeping.
+func TestHALTConsumesCyclesWhileHalted(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.HALT
+
+    // When
+    expectedCycles := 10
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if !cpu.Halted {
+        t.Error("CPU should be halted.")
+    }
+
+    if cpu.Registers.PC != 0x0101 {
+        t.Error("PC should be 0x0101, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestHALTWakesUpAndDispatches verifies that with IME = 1 a pending interrupt wakes the CPU and is serviced.
+func TestHALTWakesUpAndDispatches(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = true
+    ram(cpu)[IEAddress] = 1 << Timer
+    ram(cpu)[0x0100] = instructions.HALT
+
+    cpu.Execute(4)
+    cpu.RequestInterrupt(Timer)
+
+    // When
+    // 1 cycle to exit HALT + 5 cycles to dispatch.
+    expectedCycles := 6
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Halted {
+        t.Error("CPU should not be halted.")
+    }
+
+    if cpu.Registers.PC != 0x0050 {
+        t.Error("PC should be 0x0050, instead got: ", cpu.Registers.PC)
+    }
+
+    // Return address is the instruction after HALT.
+    if ram(cpu)[0xFFFD] != 0x01 || ram(cpu)[0xFFFC] != 0x01 {
+        t.Error("Return address 0x0101 should be pushed to the stack.")
+    }
+}
+
+// TestHALTWakesUpWithoutIME verifies that with IME = 0 a pending interrupt resumes execution without servicing it.
+func TestHALTWakesUpWithoutIME(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = false
+    ram(cpu)[IEAddress] = 1 << Serial
+    ram(cpu)[0x0100] = instructions.HALT
+    ram(cpu)[0x0101] = instructions.INC_A
+    initialA := cpu.Registers.A
+
+    cpu.Execute(3)
+    cpu.RequestInterrupt(Serial)
+
+    // When
+    expectedCycles := 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Halted {
+        t.Error("CPU should not be halted.")
+    }
+
+    if cpu.Registers.A != initialA + 1 {
+        t.Error("Instruction after HALT should be executed, A: ", cpu.Registers.A)
+    }
+
+    if cpu.Registers.PC != 0x0102 {
+        t.Error("PC should be 0x0102, instead got: ", cpu.Registers.PC)
+    }
+
+    if ram(cpu)[IFAddress] & (1 << Serial) == 0 {
+        t.Error("Serial request should not be acknowledged with IME = 0.")
+    }
+}
+
+// TestHALTBug verifies that HALT with IME = 0 and a pending interrupt does not halt,
+// and the following byte is executed twice.
+func TestHALTBug(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = false
+    ram(cpu)[IEAddress] = 1 << VBlank
+    cpu.RequestInterrupt(VBlank)
+    ram(cpu)[0x0100] = instructions.HALT
+    ram(cpu)[0x0101] = instructions.INC_A
+    initialA := cpu.Registers.A
+
+    // When
+    expectedCycles := 3
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Halted {
+        t.Error("CPU should not be halted.")
+    }
+
+    if cpu.Registers.A != initialA + 2 {
+        t.Error("INC A should be executed twice, A: ", cpu.Registers.A)
+    }
+
+    if cpu.Registers.PC != 0x0102 {
+        t.Error("PC should be 0x0102, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestHALTBugWithOperand verifies that the HALT bug also applies to multi-byte instructions:
+// the opcode is read again as its own operand.
+func TestHALTBugWithOperand(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = false
+    ram(cpu)[IEAddress] = 1 << VBlank
+    cpu.RequestInterrupt(VBlank)
+    ram(cpu)[0x0100] = instructions.HALT
+    ram(cpu)[0x0101] = instructions.LDB_IM
+    ram(cpu)[0x0102] = 0x42
+
+    // When
+    cpu.Execute(3)
+
+    if cpu.Registers.B != instructions.LDB_IM {
+        t.Error("B register should be loaded with the opcode itself, instead got: ", cpu.Registers.B)
+    }
+
+    if cpu.Registers.PC != 0x0102 {
+        t.Error("PC should be 0x0102, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestSTOPWaitsForJoypad verifies that STOP sleeps until a joypad input is requested.
+func TestSTOPWaitsForJoypad(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.STOP
+    ram(cpu)[0x0101] = 0x00
+    ram(cpu)[0x0102] = instructions.INC_A
+    initialA := cpu.Registers.A
+
+    // When
+    expectedCycles := 8
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if !cpu.Stopped {
+        t.Error("CPU should be stopped.")
+    }
+
+    cpu.RequestInterrupt(Joypad)
+    cpu.Execute(1)
+
+    if cpu.Stopped {
+        t.Error("CPU should resume after a joypad input.")
+    }
+
+    if cpu.Registers.A != initialA + 1 {
+        t.Error("Instruction after STOP should be executed, A: ", cpu.Registers.A)
+    }
+}
+
+// TestSTOPSwitchesSpeed verifies the CGB speed switch sequence.
+func TestSTOPSwitchesSpeed(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[KEY1Address] = 0x01
+    ram(cpu)[0x0100] = instructions.STOP
+    ram(cpu)[0x0101] = 0x00
+
+    // When
+    expectedCycles := 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Stopped {
+        t.Error("CPU should not be stopped after a speed switch.")
+    }
+
+    if !cpu.IsDoubleSpeed() {
+        t.Error("CPU should be in double speed mode.")
+    }
+
+    if ram(cpu)[KEY1Address] & 0x01 != 0 {
+        t.Error("Speed switch armed bit should be cleared.")
+    }
+
+    if cpu.Registers.PC != 0x0102 {
+        t.Error("PC should be 0x0102, instead got: ", cpu.Registers.PC)
+    }
+}
@@ -0,0 +1,3142 @@
+// Code generated by go run ./gen; DO NOT EDIT.
+
+package arc
+
+import "cgbemu/src/alu"
+
+// unprefixedHandlers executes the unprefixed opcodes.
+var unprefixedHandlers = [256]handler{
+	0x00: execNOP,
+	0x01: execLDBC_d16,
+	0x02: execLDBC_A,
+	0x03: execINC_BC,
+	0x04: execINC_B,
+	0x05: execDEC_B,
+	0x06: execLDB_IM,
+	0x07: execRLCA,
+	0x08: execLDa16_SP,
+	0x09: execADDHL_BC,
+	0x0A: execLDA_BC,
+	0x0B: execDEC_BC,
+	0x0C: execINC_C,
+	0x0D: execDEC_C,
+	0x0E: execLDC_d8,
+	0x0F: execRRCA,
+	0x10: execSTOP,
+	0x11: execLDDE_d16,
+	0x12: execLDDE_A,
+	0x13: execINC_DE,
+	0x14: execINC_D,
+	0x15: execDEC_D,
+	0x16: execLDD_d8,
+	0x17: execRLA,
+	0x18: execJR_e,
+	0x19: execADDHL_DE,
+	0x1A: execLDA_DE,
+	0x1B: execDEC_DE,
+	0x1C: execINC_E,
+	0x1D: execDEC_E,
+	0x1E: execLDE_d8,
+	0x1F: execRRA,
+	0x20: execJRNZ_e,
+	0x21: execLDHL_d16,
+	0x22: execLDHLinc_A,
+	0x23: execINC_HL,
+	0x24: execINC_H,
+	0x25: execDEC_H,
+	0x26: execLDH_d8,
+	0x27: execDAA,
+	0x28: execJRZ_e,
+	0x29: execADDHL_HL,
+	0x2A: execLDA_HLinc,
+	0x2B: execDEC_HL,
+	0x2C: execINC_L,
+	0x2D: execDEC_L,
+	0x2E: execLDL_d8,
+	0x2F: execCPL,
+	0x30: execJRNC_e,
+	0x31: execLDSP_d16,
+	0x32: execLDHLdec_A,
+	0x33: execINC_SP,
+	0x34: execINC_indHL,
+	0x35: execDEC_indHL,
+	0x36: execLDHL_d8,
+	0x37: execSCF,
+	0x38: execJRC_e,
+	0x39: execADDHL_SP,
+	0x3A: execLDA_HLdec,
+	0x3B: execDEC_SP,
+	0x3C: execINC_A,
+	0x3D: execDEC_A,
+	0x3E: execLDA_d8,
+	0x3F: execCCF,
+	0x40: execLDB_B,
+	0x41: execLDB_C,
+	0x42: execLDB_D,
+	0x43: execLDB_E,
+	0x44: execLDB_H,
+	0x45: execLDB_L,
+	0x46: execLDB_HL,
+	0x47: execLDB_A,
+	0x48: execLDC_B,
+	0x49: execLDC_C,
+	0x4A: execLDC_D,
+	0x4B: execLDC_E,
+	0x4C: execLDC_H,
+	0x4D: execLDC_L,
+	0x4E: execLDC_HL,
+	0x4F: execLDC_A,
+	0x50: execLDD_B,
+	0x51: execLDD_C,
+	0x52: execLDD_D,
+	0x53: execLDD_E,
+	0x54: execLDD_H,
+	0x55: execLDD_L,
+	0x56: execLDD_HL,
+	0x57: execLDD_A,
+	0x58: execLDE_B,
+	0x59: execLDE_C,
+	0x5A: execLDE_D,
+	0x5B: execLDE_E,
+	0x5C: execLDE_H,
+	0x5D: execLDE_L,
+	0x5E: execLDE_HL,
+	0x5F: execLDE_A,
+	0x60: execLDH_B,
+	0x61: execLDH_C,
+	0x62: execLDH_D,
+	0x63: execLDH_E,
+	0x64: execLDH_H,
+	0x65: execLDH_L,
+	0x66: execLDH_HL,
+	0x67: execLDH_A,
+	0x68: execLDL_B,
+	0x69: execLDL_C,
+	0x6A: execLDL_D,
+	0x6B: execLDL_E,
+	0x6C: execLDL_H,
+	0x6D: execLDL_L,
+	0x6E: execLDL_HL,
+	0x6F: execLDL_A,
+	0x70: execLDHL_B,
+	0x71: execLDHL_C,
+	0x72: execLDHL_D,
+	0x73: execLDHL_E,
+	0x74: execLDHL_H,
+	0x75: execLDHL_L,
+	0x76: execHALT,
+	0x77: execLDHL_A,
+	0x78: execLDA_B,
+	0x79: execLDA_C,
+	0x7A: execLDA_D,
+	0x7B: execLDA_E,
+	0x7C: execLDA_H,
+	0x7D: execLDA_L,
+	0x7E: execLDA_HL,
+	0x7F: execLDA_A,
+	0x80: execADD_B,
+	0x81: execADD_C,
+	0x82: execADD_D,
+	0x83: execADD_E,
+	0x84: execADD_H,
+	0x85: execADD_L,
+	0x86: execADD_indHL,
+	0x87: execADD_A,
+	0x88: execADC_B,
+	0x89: execADC_C,
+	0x8A: execADC_D,
+	0x8B: execADC_E,
+	0x8C: execADC_H,
+	0x8D: execADC_L,
+	0x8E: execADC_indHL,
+	0x8F: execADC_A,
+	0x90: execSUB_B,
+	0x91: execSUB_C,
+	0x92: execSUB_D,
+	0x93: execSUB_E,
+	0x94: execSUB_H,
+	0x95: execSUB_L,
+	0x96: execSUB_indHL,
+	0x97: execSUB_A,
+	0x98: execSBC_B,
+	0x99: execSBC_C,
+	0x9A: execSBC_D,
+	0x9B: execSBC_E,
+	0x9C: execSBC_H,
+	0x9D: execSBC_L,
+	0x9E: execSBC_indHL,
+	0x9F: execSBC_A,
+	0xA0: execAND_B,
+	0xA1: execAND_C,
+	0xA2: execAND_D,
+	0xA3: execAND_E,
+	0xA4: execAND_H,
+	0xA5: execAND_L,
+	0xA6: execAND_indHL,
+	0xA7: execAND_A,
+	0xA8: execXOR_B,
+	0xA9: execXOR_C,
+	0xAA: execXOR_D,
+	0xAB: execXOR_E,
+	0xAC: execXOR_H,
+	0xAD: execXOR_L,
+	0xAE: execXOR_indHL,
+	0xAF: execXOR_A,
+	0xB0: execOR_B,
+	0xB1: execOR_C,
+	0xB2: execOR_D,
+	0xB3: execOR_E,
+	0xB4: execOR_H,
+	0xB5: execOR_L,
+	0xB6: execOR_indHL,
+	0xB7: execOR_A,
+	0xB8: execCP_B,
+	0xB9: execCP_C,
+	0xBA: execCP_D,
+	0xBB: execCP_E,
+	0xBC: execCP_H,
+	0xBD: execCP_L,
+	0xBE: execCP_indHL,
+	0xBF: execCP_A,
+	0xC0: execRETNZ,
+	0xC1: execPOP_BC,
+	0xC2: execJPNZ_a16,
+	0xC3: execJP_a16,
+	0xC4: execCALLNZ_a16,
+	0xC5: execPUSH_BC,
+	0xC6: execADD_d8,
+	0xC7: execRST_00,
+	0xC8: execRETZ,
+	0xC9: execRET,
+	0xCA: execJPZ_a16,
+	0xCB: execPREFIX_CB,
+	0xCC: execCALLZ_a16,
+	0xCD: execCALL_a16,
+	0xCE: execADC_d8,
+	0xCF: execRST_08,
+	0xD0: execRETNC,
+	0xD1: execPOP_DE,
+	0xD2: execJPNC_a16,
+	0xD3: execILLEGAL_D3,
+	0xD4: execCALLNC_a16,
+	0xD5: execPUSH_DE,
+	0xD6: execSUB_d8,
+	0xD7: execRST_10,
+	0xD8: execRETC,
+	0xD9: execRETI,
+	0xDA: execJPC_a16,
+	0xDB: execILLEGAL_DB,
+	0xDC: execCALLC_a16,
+	0xDD: execILLEGAL_DD,
+	0xDE: execSBC_d8,
+	0xDF: execRST_18,
+	0xE0: execLDa8_A,
+	0xE1: execPOP_HL,
+	0xE2: execLDCind_A,
+	0xE3: execILLEGAL_E3,
+	0xE4: execILLEGAL_E4,
+	0xE5: execPUSH_HL,
+	0xE6: execAND_d8,
+	0xE7: execRST_20,
+	0xE8: execADDSP_e,
+	0xE9: execJP_HL,
+	0xEA: execLDa16_A,
+	0xEB: execILLEGAL_EB,
+	0xEC: execILLEGAL_EC,
+	0xED: execILLEGAL_ED,
+	0xEE: execXOR_d8,
+	0xEF: execRST_28,
+	0xF0: execLDA_a8,
+	0xF1: execPOP_AF,
+	0xF2: execLDA_Cind,
+	0xF3: execDI,
+	0xF4: execILLEGAL_F4,
+	0xF5: execPUSH_AF,
+	0xF6: execOR_d8,
+	0xF7: execRST_30,
+	0xF8: execLDHL_SPs8,
+	0xF9: execLDSP_HL,
+	0xFA: execLDA_a16,
+	0xFB: execEI,
+	0xFC: execILLEGAL_FC,
+	0xFD: execILLEGAL_FD,
+	0xFE: execCP_d8,
+	0xFF: execRST_38,
+}
+
+// execNOP executes NOP.
+func execNOP(cpu *CPU, cycles *int) {
+}
+
+// execLDBC_d16 executes LD BC, n16.
+func execLDBC_d16(cpu *CPU, cycles *int) {
+	nn := cpu.FetchWord(cycles)
+	cpu.Registers.B, cpu.Registers.C = byte(nn>>8), byte(nn)
+}
+
+// execLDBC_A executes LD (BC), A.
+func execLDBC_A(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.BC(), cpu.Registers.A)
+}
+
+// execINC_BC executes INC BC.
+func execINC_BC(cpu *CPU, cycles *int) {
+	Increment16Address(&cpu.Registers.C, &cpu.Registers.B)
+	cpu.tick(cycles)
+}
+
+// execINC_B executes INC B.
+func execINC_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Increment8(cpu.Registers.B)
+}
+
+// execDEC_B executes DEC B.
+func execDEC_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Decrement8(cpu.Registers.B)
+}
+
+// execLDB_IM executes LD B, n8.
+func execLDB_IM(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.FetchByte(cycles)
+}
+
+// execRLCA executes RLCA.
+func execRLCA(cpu *CPU, cycles *int) {
+	cpu.RLCA()
+}
+
+// execLDa16_SP executes LD (a16), SP.
+func execLDa16_SP(cpu *CPU, cycles *int) {
+	cpu.LoadAddressSP(cycles)
+}
+
+// execADDHL_BC executes ADD HL, BC.
+func execADDHL_BC(cpu *CPU, cycles *int) {
+	cpu.AddHL(cycles, cpu.BC())
+}
+
+// execLDA_BC executes LD A, (BC).
+func execLDA_BC(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.ReadByteFromMemory(cycles, cpu.BC())
+}
+
+// execDEC_BC executes DEC BC.
+func execDEC_BC(cpu *CPU, cycles *int) {
+	Decrement16Address(&cpu.Registers.C, &cpu.Registers.B)
+	cpu.tick(cycles)
+}
+
+// execINC_C executes INC C.
+func execINC_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Increment8(cpu.Registers.C)
+}
+
+// execDEC_C executes DEC C.
+func execDEC_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Decrement8(cpu.Registers.C)
+}
+
+// execLDC_d8 executes LD C, n8.
+func execLDC_d8(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.FetchByte(cycles)
+}
+
+// execRRCA executes RRCA.
+func execRRCA(cpu *CPU, cycles *int) {
+	cpu.RRCA()
+}
+
+// execSTOP executes STOP n8.
+func execSTOP(cpu *CPU, cycles *int) {
+	cpu.Stop()
+}
+
+// execLDDE_d16 executes LD DE, n16.
+func execLDDE_d16(cpu *CPU, cycles *int) {
+	nn := cpu.FetchWord(cycles)
+	cpu.Registers.D, cpu.Registers.E = byte(nn>>8), byte(nn)
+}
+
+// execLDDE_A executes LD (DE), A.
+func execLDDE_A(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.DE(), cpu.Registers.A)
+}
+
+// execINC_DE executes INC DE.
+func execINC_DE(cpu *CPU, cycles *int) {
+	Increment16Address(&cpu.Registers.E, &cpu.Registers.D)
+	cpu.tick(cycles)
+}
+
+// execINC_D executes INC D.
+func execINC_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Increment8(cpu.Registers.D)
+}
+
+// execDEC_D executes DEC D.
+func execDEC_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Decrement8(cpu.Registers.D)
+}
+
+// execLDD_d8 executes LD D, n8.
+func execLDD_d8(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.FetchByte(cycles)
+}
+
+// execRLA executes RLA.
+func execRLA(cpu *CPU, cycles *int) {
+	cpu.RLA()
+}
+
+// execJR_e executes JR e8.
+func execJR_e(cpu *CPU, cycles *int) {
+	cpu.JumpRelative(cycles, true)
+}
+
+// execADDHL_DE executes ADD HL, DE.
+func execADDHL_DE(cpu *CPU, cycles *int) {
+	cpu.AddHL(cycles, cpu.DE())
+}
+
+// execLDA_DE executes LD A, (DE).
+func execLDA_DE(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.ReadByteFromMemory(cycles, cpu.DE())
+}
+
+// execDEC_DE executes DEC DE.
+func execDEC_DE(cpu *CPU, cycles *int) {
+	Decrement16Address(&cpu.Registers.E, &cpu.Registers.D)
+	cpu.tick(cycles)
+}
+
+// execINC_E executes INC E.
+func execINC_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Increment8(cpu.Registers.E)
+}
+
+// execDEC_E executes DEC E.
+func execDEC_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Decrement8(cpu.Registers.E)
+}
+
+// execLDE_d8 executes LD E, n8.
+func execLDE_d8(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.FetchByte(cycles)
+}
+
+// execRRA executes RRA.
+func execRRA(cpu *CPU, cycles *int) {
+	cpu.RRA()
+}
+
+// execJRNZ_e executes JR NZ, e8.
+func execJRNZ_e(cpu *CPU, cycles *int) {
+	cpu.JumpRelative(cycles, !cpu.IsZflagSet())
+}
+
+// execLDHL_d16 executes LD HL, n16.
+func execLDHL_d16(cpu *CPU, cycles *int) {
+	nn := cpu.FetchWord(cycles)
+	cpu.Registers.H, cpu.Registers.L = byte(nn>>8), byte(nn)
+}
+
+// execLDHLinc_A executes LD (HL+), A.
+func execLDHLinc_A(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Registers.A)
+	Increment16Address(&cpu.Registers.L, &cpu.Registers.H)
+}
+
+// execINC_HL executes INC HL.
+func execINC_HL(cpu *CPU, cycles *int) {
+	Increment16Address(&cpu.Registers.L, &cpu.Registers.H)
+	cpu.tick(cycles)
+}
+
+// execINC_H executes INC H.
+func execINC_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Increment8(cpu.Registers.H)
+}
+
+// execDEC_H executes DEC H.
+func execDEC_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Decrement8(cpu.Registers.H)
+}
+
+// execLDH_d8 executes LD H, n8.
+func execLDH_d8(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.FetchByte(cycles)
+}
+
+// execDAA executes DAA.
+func execDAA(cpu *CPU, cycles *int) {
+	cpu.DAA()
+}
+
+// execJRZ_e executes JR Z, e8.
+func execJRZ_e(cpu *CPU, cycles *int) {
+	cpu.JumpRelative(cycles, cpu.IsZflagSet())
+}
+
+// execADDHL_HL executes ADD HL, HL.
+func execADDHL_HL(cpu *CPU, cycles *int) {
+	cpu.AddHL(cycles, cpu.HL())
+}
+
+// execLDA_HLinc executes LD A, (HL+).
+func execLDA_HLinc(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.ReadByteFromMemory(cycles, cpu.HL())
+	Increment16Address(&cpu.Registers.L, &cpu.Registers.H)
+}
+
+// execDEC_HL executes DEC HL.
+func execDEC_HL(cpu *CPU, cycles *int) {
+	Decrement16Address(&cpu.Registers.L, &cpu.Registers.H)
+	cpu.tick(cycles)
+}
+
+// execINC_L executes INC L.
+func execINC_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Increment8(cpu.Registers.L)
+}
+
+// execDEC_L executes DEC L.
+func execDEC_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Decrement8(cpu.Registers.L)
+}
+
+// execLDL_d8 executes LD L, n8.
+func execLDL_d8(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.FetchByte(cycles)
+}
+
+// execCPL executes CPL.
+func execCPL(cpu *CPU, cycles *int) {
+	cpu.CPL()
+}
+
+// execJRNC_e executes JR NC, e8.
+func execJRNC_e(cpu *CPU, cycles *int) {
+	cpu.JumpRelative(cycles, !cpu.IsCflagSet())
+}
+
+// execLDSP_d16 executes LD SP, n16.
+func execLDSP_d16(cpu *CPU, cycles *int) {
+	nn := cpu.FetchWord(cycles)
+	cpu.Registers.SP = nn
+}
+
+// execLDHLdec_A executes LD (HL-), A.
+func execLDHLdec_A(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Registers.A)
+	Decrement16Address(&cpu.Registers.L, &cpu.Registers.H)
+}
+
+// execINC_SP executes INC SP.
+func execINC_SP(cpu *CPU, cycles *int) {
+	cpu.Registers.SP++
+	cpu.tick(cycles)
+}
+
+// execINC_indHL executes INC (HL).
+func execINC_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Increment8(value))
+}
+
+// execDEC_indHL executes DEC (HL).
+func execDEC_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Decrement8(value))
+}
+
+// execLDHL_d8 executes LD (HL), n8.
+func execLDHL_d8(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.FetchByte(cycles))
+}
+
+// execSCF executes SCF.
+func execSCF(cpu *CPU, cycles *int) {
+	cpu.SCF()
+}
+
+// execJRC_e executes JR C, e8.
+func execJRC_e(cpu *CPU, cycles *int) {
+	cpu.JumpRelative(cycles, cpu.IsCflagSet())
+}
+
+// execADDHL_SP executes ADD HL, SP.
+func execADDHL_SP(cpu *CPU, cycles *int) {
+	cpu.AddHL(cycles, cpu.Registers.SP)
+}
+
+// execLDA_HLdec executes LD A, (HL-).
+func execLDA_HLdec(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.ReadByteFromMemory(cycles, cpu.HL())
+	Decrement16Address(&cpu.Registers.L, &cpu.Registers.H)
+}
+
+// execDEC_SP executes DEC SP.
+func execDEC_SP(cpu *CPU, cycles *int) {
+	cpu.Registers.SP--
+	cpu.tick(cycles)
+}
+
+// execINC_A executes INC A.
+func execINC_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Increment8(cpu.Registers.A)
+}
+
+// execDEC_A executes DEC A.
+func execDEC_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Decrement8(cpu.Registers.A)
+}
+
+// execLDA_d8 executes LD A, n8.
+func execLDA_d8(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.FetchByte(cycles)
+}
+
+// execCCF executes CCF.
+func execCCF(cpu *CPU, cycles *int) {
+	cpu.CCF()
+}
+
+// execLDB_B executes LD B, B.
+func execLDB_B(cpu *CPU, cycles *int) {
+	// Loading a register into itself does nothing.
+}
+
+// execLDB_C executes LD B, C.
+func execLDB_C(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.C
+}
+
+// execLDB_D executes LD B, D.
+func execLDB_D(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.D
+}
+
+// execLDB_E executes LD B, E.
+func execLDB_E(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.E
+}
+
+// execLDB_H executes LD B, H.
+func execLDB_H(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.H
+}
+
+// execLDB_L executes LD B, L.
+func execLDB_L(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.L
+}
+
+// execLDB_HL executes LD B, (HL).
+func execLDB_HL(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.ReadByteFromMemory(cycles, cpu.HL())
+}
+
+// execLDB_A executes LD B, A.
+func execLDB_A(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.A
+}
+
+// execLDC_B executes LD C, B.
+func execLDC_B(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.B
+}
+
+// execLDC_C executes LD C, C.
+func execLDC_C(cpu *CPU, cycles *int) {
+	// Loading a register into itself does nothing.
+}
+
+// execLDC_D executes LD C, D.
+func execLDC_D(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.D
+}
+
+// execLDC_E executes LD C, E.
+func execLDC_E(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.E
+}
+
+// execLDC_H executes LD C, H.
+func execLDC_H(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.H
+}
+
+// execLDC_L executes LD C, L.
+func execLDC_L(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.L
+}
+
+// execLDC_HL executes LD C, (HL).
+func execLDC_HL(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.ReadByteFromMemory(cycles, cpu.HL())
+}
+
+// execLDC_A executes LD C, A.
+func execLDC_A(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.A
+}
+
+// execLDD_B executes LD D, B.
+func execLDD_B(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.B
+}
+
+// execLDD_C executes LD D, C.
+func execLDD_C(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.C
+}
+
+// execLDD_D executes LD D, D.
+func execLDD_D(cpu *CPU, cycles *int) {
+	// Loading a register into itself does nothing.
+}
+
+// execLDD_E executes LD D, E.
+func execLDD_E(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.E
+}
+
+// execLDD_H executes LD D, H.
+func execLDD_H(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.H
+}
+
+// execLDD_L executes LD D, L.
+func execLDD_L(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.L
+}
+
+// execLDD_HL executes LD D, (HL).
+func execLDD_HL(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.ReadByteFromMemory(cycles, cpu.HL())
+}
+
+// execLDD_A executes LD D, A.
+func execLDD_A(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.A
+}
+
+// execLDE_B executes LD E, B.
+func execLDE_B(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.B
+}
+
+// execLDE_C executes LD E, C.
+func execLDE_C(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.C
+}
+
+// execLDE_D executes LD E, D.
+func execLDE_D(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.D
+}
+
+// execLDE_E executes LD E, E.
+func execLDE_E(cpu *CPU, cycles *int) {
+	// Loading a register into itself does nothing.
+}
+
+// execLDE_H executes LD E, H.
+func execLDE_H(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.H
+}
+
+// execLDE_L executes LD E, L.
+func execLDE_L(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.L
+}
+
+// execLDE_HL executes LD E, (HL).
+func execLDE_HL(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.ReadByteFromMemory(cycles, cpu.HL())
+}
+
+// execLDE_A executes LD E, A.
+func execLDE_A(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.A
+}
+
+// execLDH_B executes LD H, B.
+func execLDH_B(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.B
+}
+
+// execLDH_C executes LD H, C.
+func execLDH_C(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.C
+}
+
+// execLDH_D executes LD H, D.
+func execLDH_D(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.D
+}
+
+// execLDH_E executes LD H, E.
+func execLDH_E(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.E
+}
+
+// execLDH_H executes LD H, H.
+func execLDH_H(cpu *CPU, cycles *int) {
+	// Loading a register into itself does nothing.
+}
+
+// execLDH_L executes LD H, L.
+func execLDH_L(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.L
+}
+
+// execLDH_HL executes LD H, (HL).
+func execLDH_HL(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.ReadByteFromMemory(cycles, cpu.HL())
+}
+
+// execLDH_A executes LD H, A.
+func execLDH_A(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.A
+}
+
+// execLDL_B executes LD L, B.
+func execLDL_B(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.B
+}
+
+// execLDL_C executes LD L, C.
+func execLDL_C(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.C
+}
+
+// execLDL_D executes LD L, D.
+func execLDL_D(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.D
+}
+
+// execLDL_E executes LD L, E.
+func execLDL_E(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.E
+}
+
+// execLDL_H executes LD L, H.
+func execLDL_H(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.H
+}
+
+// execLDL_L executes LD L, L.
+func execLDL_L(cpu *CPU, cycles *int) {
+	// Loading a register into itself does nothing.
+}
+
+// execLDL_HL executes LD L, (HL).
+func execLDL_HL(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.ReadByteFromMemory(cycles, cpu.HL())
+}
+
+// execLDL_A executes LD L, A.
+func execLDL_A(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.A
+}
+
+// execLDHL_B executes LD (HL), B.
+func execLDHL_B(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Registers.B)
+}
+
+// execLDHL_C executes LD (HL), C.
+func execLDHL_C(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Registers.C)
+}
+
+// execLDHL_D executes LD (HL), D.
+func execLDHL_D(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Registers.D)
+}
+
+// execLDHL_E executes LD (HL), E.
+func execLDHL_E(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Registers.E)
+}
+
+// execLDHL_H executes LD (HL), H.
+func execLDHL_H(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Registers.H)
+}
+
+// execLDHL_L executes LD (HL), L.
+func execLDHL_L(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Registers.L)
+}
+
+// execHALT executes HALT.
+func execHALT(cpu *CPU, cycles *int) {
+	cpu.Halt()
+}
+
+// execLDHL_A executes LD (HL), A.
+func execLDHL_A(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.HL(), cpu.Registers.A)
+}
+
+// execLDA_B executes LD A, B.
+func execLDA_B(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.B
+}
+
+// execLDA_C executes LD A, C.
+func execLDA_C(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.C
+}
+
+// execLDA_D executes LD A, D.
+func execLDA_D(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.D
+}
+
+// execLDA_E executes LD A, E.
+func execLDA_E(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.E
+}
+
+// execLDA_H executes LD A, H.
+func execLDA_H(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.H
+}
+
+// execLDA_L executes LD A, L.
+func execLDA_L(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.L
+}
+
+// execLDA_HL executes LD A, (HL).
+func execLDA_HL(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.ReadByteFromMemory(cycles, cpu.HL())
+}
+
+// execLDA_A executes LD A, A.
+func execLDA_A(cpu *CPU, cycles *int) {
+	// Loading a register into itself does nothing.
+}
+
+// execADD_B executes ADD A, B.
+func execADD_B(cpu *CPU, cycles *int) {
+	cpu.Add8(cpu.Registers.B)
+}
+
+// execADD_C executes ADD A, C.
+func execADD_C(cpu *CPU, cycles *int) {
+	cpu.Add8(cpu.Registers.C)
+}
+
+// execADD_D executes ADD A, D.
+func execADD_D(cpu *CPU, cycles *int) {
+	cpu.Add8(cpu.Registers.D)
+}
+
+// execADD_E executes ADD A, E.
+func execADD_E(cpu *CPU, cycles *int) {
+	cpu.Add8(cpu.Registers.E)
+}
+
+// execADD_H executes ADD A, H.
+func execADD_H(cpu *CPU, cycles *int) {
+	cpu.Add8(cpu.Registers.H)
+}
+
+// execADD_L executes ADD A, L.
+func execADD_L(cpu *CPU, cycles *int) {
+	cpu.Add8(cpu.Registers.L)
+}
+
+// execADD_indHL executes ADD A, (HL).
+func execADD_indHL(cpu *CPU, cycles *int) {
+	cpu.Add8(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+}
+
+// execADD_A executes ADD A, A.
+func execADD_A(cpu *CPU, cycles *int) {
+	cpu.Add8(cpu.Registers.A)
+}
+
+// execADC_B executes ADC A, B.
+func execADC_B(cpu *CPU, cycles *int) {
+	cpu.AddWithCarry8(cpu.Registers.B)
+}
+
+// execADC_C executes ADC A, C.
+func execADC_C(cpu *CPU, cycles *int) {
+	cpu.AddWithCarry8(cpu.Registers.C)
+}
+
+// execADC_D executes ADC A, D.
+func execADC_D(cpu *CPU, cycles *int) {
+	cpu.AddWithCarry8(cpu.Registers.D)
+}
+
+// execADC_E executes ADC A, E.
+func execADC_E(cpu *CPU, cycles *int) {
+	cpu.AddWithCarry8(cpu.Registers.E)
+}
+
+// execADC_H executes ADC A, H.
+func execADC_H(cpu *CPU, cycles *int) {
+	cpu.AddWithCarry8(cpu.Registers.H)
+}
+
+// execADC_L executes ADC A, L.
+func execADC_L(cpu *CPU, cycles *int) {
+	cpu.AddWithCarry8(cpu.Registers.L)
+}
+
+// execADC_indHL executes ADC A, (HL).
+func execADC_indHL(cpu *CPU, cycles *int) {
+	cpu.AddWithCarry8(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+}
+
+// execADC_A executes ADC A, A.
+func execADC_A(cpu *CPU, cycles *int) {
+	cpu.AddWithCarry8(cpu.Registers.A)
+}
+
+// execSUB_B executes SUB A, B.
+func execSUB_B(cpu *CPU, cycles *int) {
+	cpu.Sub8(cpu.Registers.B)
+}
+
+// execSUB_C executes SUB A, C.
+func execSUB_C(cpu *CPU, cycles *int) {
+	cpu.Sub8(cpu.Registers.C)
+}
+
+// execSUB_D executes SUB A, D.
+func execSUB_D(cpu *CPU, cycles *int) {
+	cpu.Sub8(cpu.Registers.D)
+}
+
+// execSUB_E executes SUB A, E.
+func execSUB_E(cpu *CPU, cycles *int) {
+	cpu.Sub8(cpu.Registers.E)
+}
+
+// execSUB_H executes SUB A, H.
+func execSUB_H(cpu *CPU, cycles *int) {
+	cpu.Sub8(cpu.Registers.H)
+}
+
+// execSUB_L executes SUB A, L.
+func execSUB_L(cpu *CPU, cycles *int) {
+	cpu.Sub8(cpu.Registers.L)
+}
+
+// execSUB_indHL executes SUB A, (HL).
+func execSUB_indHL(cpu *CPU, cycles *int) {
+	cpu.Sub8(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+}
+
+// execSUB_A executes SUB A, A.
+func execSUB_A(cpu *CPU, cycles *int) {
+	cpu.Sub8(cpu.Registers.A)
+}
+
+// execSBC_B executes SBC A, B.
+func execSBC_B(cpu *CPU, cycles *int) {
+	cpu.SubWithCarry8(cpu.Registers.B)
+}
+
+// execSBC_C executes SBC A, C.
+func execSBC_C(cpu *CPU, cycles *int) {
+	cpu.SubWithCarry8(cpu.Registers.C)
+}
+
+// execSBC_D executes SBC A, D.
+func execSBC_D(cpu *CPU, cycles *int) {
+	cpu.SubWithCarry8(cpu.Registers.D)
+}
+
+// execSBC_E executes SBC A, E.
+func execSBC_E(cpu *CPU, cycles *int) {
+	cpu.SubWithCarry8(cpu.Registers.E)
+}
+
+// execSBC_H executes SBC A, H.
+func execSBC_H(cpu *CPU, cycles *int) {
+	cpu.SubWithCarry8(cpu.Registers.H)
+}
+
+// execSBC_L executes SBC A, L.
+func execSBC_L(cpu *CPU, cycles *int) {
+	cpu.SubWithCarry8(cpu.Registers.L)
+}
+
+// execSBC_indHL executes SBC A, (HL).
+func execSBC_indHL(cpu *CPU, cycles *int) {
+	cpu.SubWithCarry8(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+}
+
+// execSBC_A executes SBC A, A.
+func execSBC_A(cpu *CPU, cycles *int) {
+	cpu.SubWithCarry8(cpu.Registers.A)
+}
+
+// execAND_B executes AND A, B.
+func execAND_B(cpu *CPU, cycles *int) {
+	cpu.And8(cpu.Registers.B)
+}
+
+// execAND_C executes AND A, C.
+func execAND_C(cpu *CPU, cycles *int) {
+	cpu.And8(cpu.Registers.C)
+}
+
+// execAND_D executes AND A, D.
+func execAND_D(cpu *CPU, cycles *int) {
+	cpu.And8(cpu.Registers.D)
+}
+
+// execAND_E executes AND A, E.
+func execAND_E(cpu *CPU, cycles *int) {
+	cpu.And8(cpu.Registers.E)
+}
+
+// execAND_H executes AND A, H.
+func execAND_H(cpu *CPU, cycles *int) {
+	cpu.And8(cpu.Registers.H)
+}
+
+// execAND_L executes AND A, L.
+func execAND_L(cpu *CPU, cycles *int) {
+	cpu.And8(cpu.Registers.L)
+}
+
+// execAND_indHL executes AND A, (HL).
+func execAND_indHL(cpu *CPU, cycles *int) {
+	cpu.And8(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+}
+
+// execAND_A executes AND A, A.
+func execAND_A(cpu *CPU, cycles *int) {
+	cpu.And8(cpu.Registers.A)
+}
+
+// execXOR_B executes XOR A, B.
+func execXOR_B(cpu *CPU, cycles *int) {
+	cpu.Xor8(cpu.Registers.B)
+}
+
+// execXOR_C executes XOR A, C.
+func execXOR_C(cpu *CPU, cycles *int) {
+	cpu.Xor8(cpu.Registers.C)
+}
+
+// execXOR_D executes XOR A, D.
+func execXOR_D(cpu *CPU, cycles *int) {
+	cpu.Xor8(cpu.Registers.D)
+}
+
+// execXOR_E executes XOR A, E.
+func execXOR_E(cpu *CPU, cycles *int) {
+	cpu.Xor8(cpu.Registers.E)
+}
+
+// execXOR_H executes XOR A, H.
+func execXOR_H(cpu *CPU, cycles *int) {
+	cpu.Xor8(cpu.Registers.H)
+}
+
+// execXOR_L executes XOR A, L.
+func execXOR_L(cpu *CPU, cycles *int) {
+	cpu.Xor8(cpu.Registers.L)
+}
+
+// execXOR_indHL executes XOR A, (HL).
+func execXOR_indHL(cpu *CPU, cycles *int) {
+	cpu.Xor8(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+}
+
+// execXOR_A executes XOR A, A.
+func execXOR_A(cpu *CPU, cycles *int) {
+	cpu.Xor8(cpu.Registers.A)
+}
+
+// execOR_B executes OR A, B.
+func execOR_B(cpu *CPU, cycles *int) {
+	cpu.Or8(cpu.Registers.B)
+}
+
+// execOR_C executes OR A, C.
+func execOR_C(cpu *CPU, cycles *int) {
+	cpu.Or8(cpu.Registers.C)
+}
+
+// execOR_D executes OR A, D.
+func execOR_D(cpu *CPU, cycles *int) {
+	cpu.Or8(cpu.Registers.D)
+}
+
+// execOR_E executes OR A, E.
+func execOR_E(cpu *CPU, cycles *int) {
+	cpu.Or8(cpu.Registers.E)
+}
+
+// execOR_H executes OR A, H.
+func execOR_H(cpu *CPU, cycles *int) {
+	cpu.Or8(cpu.Registers.H)
+}
+
+// execOR_L executes OR A, L.
+func execOR_L(cpu *CPU, cycles *int) {
+	cpu.Or8(cpu.Registers.L)
+}
+
+// execOR_indHL executes OR A, (HL).
+func execOR_indHL(cpu *CPU, cycles *int) {
+	cpu.Or8(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+}
+
+// execOR_A executes OR A, A.
+func execOR_A(cpu *CPU, cycles *int) {
+	cpu.Or8(cpu.Registers.A)
+}
+
+// execCP_B executes CP A, B.
+func execCP_B(cpu *CPU, cycles *int) {
+	cpu.Compare8(cpu.Registers.B)
+}
+
+// execCP_C executes CP A, C.
+func execCP_C(cpu *CPU, cycles *int) {
+	cpu.Compare8(cpu.Registers.C)
+}
+
+// execCP_D executes CP A, D.
+func execCP_D(cpu *CPU, cycles *int) {
+	cpu.Compare8(cpu.Registers.D)
+}
+
+// execCP_E executes CP A, E.
+func execCP_E(cpu *CPU, cycles *int) {
+	cpu.Compare8(cpu.Registers.E)
+}
+
+// execCP_H executes CP A, H.
+func execCP_H(cpu *CPU, cycles *int) {
+	cpu.Compare8(cpu.Registers.H)
+}
+
+// execCP_L executes CP A, L.
+func execCP_L(cpu *CPU, cycles *int) {
+	cpu.Compare8(cpu.Registers.L)
+}
+
+// execCP_indHL executes CP A, (HL).
+func execCP_indHL(cpu *CPU, cycles *int) {
+	cpu.Compare8(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+}
+
+// execCP_A executes CP A, A.
+func execCP_A(cpu *CPU, cycles *int) {
+	cpu.Compare8(cpu.Registers.A)
+}
+
+// execRETNZ executes RET NZ.
+func execRETNZ(cpu *CPU, cycles *int) {
+	cpu.ReturnIf(cycles, !cpu.IsZflagSet())
+}
+
+// execPOP_BC executes POP BC.
+func execPOP_BC(cpu *CPU, cycles *int) {
+	lsb := cpu.PopFromSP(cycles)
+	msb := cpu.PopFromSP(cycles)
+	cpu.Registers.B, cpu.Registers.C = msb, lsb
+}
+
+// execJPNZ_a16 executes JP NZ, a16.
+func execJPNZ_a16(cpu *CPU, cycles *int) {
+	cpu.JumpAbsolute(cycles, !cpu.IsZflagSet())
+}
+
+// execJP_a16 executes JP a16.
+func execJP_a16(cpu *CPU, cycles *int) {
+	cpu.JumpAbsolute(cycles, true)
+}
+
+// execCALLNZ_a16 executes CALL NZ, a16.
+func execCALLNZ_a16(cpu *CPU, cycles *int) {
+	cpu.Call(cycles, !cpu.IsZflagSet())
+}
+
+// execPUSH_BC executes PUSH BC.
+func execPUSH_BC(cpu *CPU, cycles *int) {
+	cpu.Push(cycles, cpu.Registers.B, cpu.Registers.C)
+}
+
+// execADD_d8 executes ADD A, n8.
+func execADD_d8(cpu *CPU, cycles *int) {
+	cpu.Add8(cpu.FetchByte(cycles))
+}
+
+// execRST_00 executes RST 00H.
+func execRST_00(cpu *CPU, cycles *int) {
+	cpu.Restart(cycles, 0x00)
+}
+
+// execRETZ executes RET Z.
+func execRETZ(cpu *CPU, cycles *int) {
+	cpu.ReturnIf(cycles, cpu.IsZflagSet())
+}
+
+// execRET executes RET.
+func execRET(cpu *CPU, cycles *int) {
+	cpu.Return(cycles)
+}
+
+// execJPZ_a16 executes JP Z, a16.
+func execJPZ_a16(cpu *CPU, cycles *int) {
+	cpu.JumpAbsolute(cycles, cpu.IsZflagSet())
+}
+
+// execPREFIX_CB executes PREFIX.
+func execPREFIX_CB(cpu *CPU, cycles *int) {
+	cpu.ExecuteCB(cycles)
+}
+
+// execCALLZ_a16 executes CALL Z, a16.
+func execCALLZ_a16(cpu *CPU, cycles *int) {
+	cpu.Call(cycles, cpu.IsZflagSet())
+}
+
+// execCALL_a16 executes CALL a16.
+func execCALL_a16(cpu *CPU, cycles *int) {
+	cpu.Call(cycles, true)
+}
+
+// execADC_d8 executes ADC A, n8.
+func execADC_d8(cpu *CPU, cycles *int) {
+	cpu.AddWithCarry8(cpu.FetchByte(cycles))
+}
+
+// execRST_08 executes RST 08H.
+func execRST_08(cpu *CPU, cycles *int) {
+	cpu.Restart(cycles, 0x08)
+}
+
+// execRETNC executes RET NC.
+func execRETNC(cpu *CPU, cycles *int) {
+	cpu.ReturnIf(cycles, !cpu.IsCflagSet())
+}
+
+// execPOP_DE executes POP DE.
+func execPOP_DE(cpu *CPU, cycles *int) {
+	lsb := cpu.PopFromSP(cycles)
+	msb := cpu.PopFromSP(cycles)
+	cpu.Registers.D, cpu.Registers.E = msb, lsb
+}
+
+// execJPNC_a16 executes JP NC, a16.
+func execJPNC_a16(cpu *CPU, cycles *int) {
+	cpu.JumpAbsolute(cycles, !cpu.IsCflagSet())
+}
+
+// execILLEGAL_D3 executes ILLEGAL.
+func execILLEGAL_D3(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execCALLNC_a16 executes CALL NC, a16.
+func execCALLNC_a16(cpu *CPU, cycles *int) {
+	cpu.Call(cycles, !cpu.IsCflagSet())
+}
+
+// execPUSH_DE executes PUSH DE.
+func execPUSH_DE(cpu *CPU, cycles *int) {
+	cpu.Push(cycles, cpu.Registers.D, cpu.Registers.E)
+}
+
+// execSUB_d8 executes SUB A, n8.
+func execSUB_d8(cpu *CPU, cycles *int) {
+	cpu.Sub8(cpu.FetchByte(cycles))
+}
+
+// execRST_10 executes RST 10H.
+func execRST_10(cpu *CPU, cycles *int) {
+	cpu.Restart(cycles, 0x10)
+}
+
+// execRETC executes RET C.
+func execRETC(cpu *CPU, cycles *int) {
+	cpu.ReturnIf(cycles, cpu.IsCflagSet())
+}
+
+// execRETI executes RETI.
+func execRETI(cpu *CPU, cycles *int) {
+	cpu.ReturnFromInterrupt(cycles)
+}
+
+// execJPC_a16 executes JP C, a16.
+func execJPC_a16(cpu *CPU, cycles *int) {
+	cpu.JumpAbsolute(cycles, cpu.IsCflagSet())
+}
+
+// execILLEGAL_DB executes ILLEGAL.
+func execILLEGAL_DB(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execCALLC_a16 executes CALL C, a16.
+func execCALLC_a16(cpu *CPU, cycles *int) {
+	cpu.Call(cycles, cpu.IsCflagSet())
+}
+
+// execILLEGAL_DD executes ILLEGAL.
+func execILLEGAL_DD(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execSBC_d8 executes SBC A, n8.
+func execSBC_d8(cpu *CPU, cycles *int) {
+	cpu.SubWithCarry8(cpu.FetchByte(cycles))
+}
+
+// execRST_18 executes RST 18H.
+func execRST_18(cpu *CPU, cycles *int) {
+	cpu.Restart(cycles, 0x18)
+}
+
+// execLDa8_A executes LDH (a8), A.
+func execLDa8_A(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, 0xFF00|uint16(cpu.FetchByte(cycles)), cpu.Registers.A)
+}
+
+// execPOP_HL executes POP HL.
+func execPOP_HL(cpu *CPU, cycles *int) {
+	lsb := cpu.PopFromSP(cycles)
+	msb := cpu.PopFromSP(cycles)
+	cpu.Registers.H, cpu.Registers.L = msb, lsb
+}
+
+// execLDCind_A executes LD (C), A.
+func execLDCind_A(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, 0xFF00|uint16(cpu.Registers.C), cpu.Registers.A)
+}
+
+// execILLEGAL_E3 executes ILLEGAL.
+func execILLEGAL_E3(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execILLEGAL_E4 executes ILLEGAL.
+func execILLEGAL_E4(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execPUSH_HL executes PUSH HL.
+func execPUSH_HL(cpu *CPU, cycles *int) {
+	cpu.Push(cycles, cpu.Registers.H, cpu.Registers.L)
+}
+
+// execAND_d8 executes AND A, n8.
+func execAND_d8(cpu *CPU, cycles *int) {
+	cpu.And8(cpu.FetchByte(cycles))
+}
+
+// execRST_20 executes RST 20H.
+func execRST_20(cpu *CPU, cycles *int) {
+	cpu.Restart(cycles, 0x20)
+}
+
+// execADDSP_e executes ADD SP, e8.
+func execADDSP_e(cpu *CPU, cycles *int) {
+	cpu.AddSPOffset(cycles)
+}
+
+// execJP_HL executes JP HL.
+func execJP_HL(cpu *CPU, cycles *int) {
+	cpu.Registers.PC = cpu.HL()
+}
+
+// execLDa16_A executes LD (a16), A.
+func execLDa16_A(cpu *CPU, cycles *int) {
+	cpu.WriteByteToMemory(cycles, cpu.FetchWord(cycles), cpu.Registers.A)
+}
+
+// execILLEGAL_EB executes ILLEGAL.
+func execILLEGAL_EB(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execILLEGAL_EC executes ILLEGAL.
+func execILLEGAL_EC(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execILLEGAL_ED executes ILLEGAL.
+func execILLEGAL_ED(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execXOR_d8 executes XOR A, n8.
+func execXOR_d8(cpu *CPU, cycles *int) {
+	cpu.Xor8(cpu.FetchByte(cycles))
+}
+
+// execRST_28 executes RST 28H.
+func execRST_28(cpu *CPU, cycles *int) {
+	cpu.Restart(cycles, 0x28)
+}
+
+// execLDA_a8 executes LDH A, (a8).
+func execLDA_a8(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.ReadByteFromMemory(cycles, 0xFF00|uint16(cpu.FetchByte(cycles)))
+}
+
+// execPOP_AF executes POP AF.
+func execPOP_AF(cpu *CPU, cycles *int) {
+	lsb := cpu.PopFromSP(cycles)
+	msb := cpu.PopFromSP(cycles)
+	cpu.Registers.A, cpu.Registers.F = msb, lsb&0xF0
+}
+
+// execLDA_Cind executes LD A, (C).
+func execLDA_Cind(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.ReadByteFromMemory(cycles, 0xFF00|uint16(cpu.Registers.C))
+}
+
+// execDI executes DI.
+func execDI(cpu *CPU, cycles *int) {
+	cpu.DisableInterrupts()
+}
+
+// execILLEGAL_F4 executes ILLEGAL.
+func execILLEGAL_F4(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execPUSH_AF executes PUSH AF.
+func execPUSH_AF(cpu *CPU, cycles *int) {
+	cpu.Push(cycles, cpu.Registers.A, cpu.Registers.F)
+}
+
+// execOR_d8 executes OR A, n8.
+func execOR_d8(cpu *CPU, cycles *int) {
+	cpu.Or8(cpu.FetchByte(cycles))
+}
+
+// execRST_30 executes RST 30H.
+func execRST_30(cpu *CPU, cycles *int) {
+	cpu.Restart(cycles, 0x30)
+}
+
+// execLDHL_SPs8 executes LD HL, SP+e8.
+func execLDHL_SPs8(cpu *CPU, cycles *int) {
+	cpu.LoadHLSPOffset(cycles)
+}
+
+// execLDSP_HL executes LD SP, HL.
+func execLDSP_HL(cpu *CPU, cycles *int) {
+	cpu.LoadSPHL(cycles)
+}
+
+// execLDA_a16 executes LD A, (a16).
+func execLDA_a16(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.ReadByteFromMemory(cycles, cpu.FetchWord(cycles))
+}
+
+// execEI executes EI.
+func execEI(cpu *CPU, cycles *int) {
+	cpu.EnableInterrupts()
+}
+
+// execILLEGAL_FC executes ILLEGAL.
+func execILLEGAL_FC(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execILLEGAL_FD executes ILLEGAL.
+func execILLEGAL_FD(cpu *CPU, cycles *int) {
+	cpu.LockUp()
+}
+
+// execCP_d8 executes CP A, n8.
+func execCP_d8(cpu *CPU, cycles *int) {
+	cpu.Compare8(cpu.FetchByte(cycles))
+}
+
+// execRST_38 executes RST 38H.
+func execRST_38(cpu *CPU, cycles *int) {
+	cpu.Restart(cycles, 0x38)
+}
+
+// cbHandlers executes the CB-prefixed opcodes, following PREFIX_CB.
+var cbHandlers = [256]handler{
+	0x00: execCB_RLC_B,
+	0x01: execCB_RLC_C,
+	0x02: execCB_RLC_D,
+	0x03: execCB_RLC_E,
+	0x04: execCB_RLC_H,
+	0x05: execCB_RLC_L,
+	0x06: execCB_RLC_indHL,
+	0x07: execCB_RLC_A,
+	0x08: execCB_RRC_B,
+	0x09: execCB_RRC_C,
+	0x0A: execCB_RRC_D,
+	0x0B: execCB_RRC_E,
+	0x0C: execCB_RRC_H,
+	0x0D: execCB_RRC_L,
+	0x0E: execCB_RRC_indHL,
+	0x0F: execCB_RRC_A,
+	0x10: execCB_RL_B,
+	0x11: execCB_RL_C,
+	0x12: execCB_RL_D,
+	0x13: execCB_RL_E,
+	0x14: execCB_RL_H,
+	0x15: execCB_RL_L,
+	0x16: execCB_RL_indHL,
+	0x17: execCB_RL_A,
+	0x18: execCB_RR_B,
+	0x19: execCB_RR_C,
+	0x1A: execCB_RR_D,
+	0x1B: execCB_RR_E,
+	0x1C: execCB_RR_H,
+	0x1D: execCB_RR_L,
+	0x1E: execCB_RR_indHL,
+	0x1F: execCB_RR_A,
+	0x20: execCB_SLA_B,
+	0x21: execCB_SLA_C,
+	0x22: execCB_SLA_D,
+	0x23: execCB_SLA_E,
+	0x24: execCB_SLA_H,
+	0x25: execCB_SLA_L,
+	0x26: execCB_SLA_indHL,
+	0x27: execCB_SLA_A,
+	0x28: execCB_SRA_B,
+	0x29: execCB_SRA_C,
+	0x2A: execCB_SRA_D,
+	0x2B: execCB_SRA_E,
+	0x2C: execCB_SRA_H,
+	0x2D: execCB_SRA_L,
+	0x2E: execCB_SRA_indHL,
+	0x2F: execCB_SRA_A,
+	0x30: execCB_SWAP_B,
+	0x31: execCB_SWAP_C,
+	0x32: execCB_SWAP_D,
+	0x33: execCB_SWAP_E,
+	0x34: execCB_SWAP_H,
+	0x35: execCB_SWAP_L,
+	0x36: execCB_SWAP_indHL,
+	0x37: execCB_SWAP_A,
+	0x38: execCB_SRL_B,
+	0x39: execCB_SRL_C,
+	0x3A: execCB_SRL_D,
+	0x3B: execCB_SRL_E,
+	0x3C: execCB_SRL_H,
+	0x3D: execCB_SRL_L,
+	0x3E: execCB_SRL_indHL,
+	0x3F: execCB_SRL_A,
+	0x40: execCB_BIT0_B,
+	0x41: execCB_BIT0_C,
+	0x42: execCB_BIT0_D,
+	0x43: execCB_BIT0_E,
+	0x44: execCB_BIT0_H,
+	0x45: execCB_BIT0_L,
+	0x46: execCB_BIT0_indHL,
+	0x47: execCB_BIT0_A,
+	0x48: execCB_BIT1_B,
+	0x49: execCB_BIT1_C,
+	0x4A: execCB_BIT1_D,
+	0x4B: execCB_BIT1_E,
+	0x4C: execCB_BIT1_H,
+	0x4D: execCB_BIT1_L,
+	0x4E: execCB_BIT1_indHL,
+	0x4F: execCB_BIT1_A,
+	0x50: execCB_BIT2_B,
+	0x51: execCB_BIT2_C,
+	0x52: execCB_BIT2_D,
+	0x53: execCB_BIT2_E,
+	0x54: execCB_BIT2_H,
+	0x55: execCB_BIT2_L,
+	0x56: execCB_BIT2_indHL,
+	0x57: execCB_BIT2_A,
+	0x58: execCB_BIT3_B,
+	0x59: execCB_BIT3_C,
+	0x5A: execCB_BIT3_D,
+	0x5B: execCB_BIT3_E,
+	0x5C: execCB_BIT3_H,
+	0x5D: execCB_BIT3_L,
+	0x5E: execCB_BIT3_indHL,
+	0x5F: execCB_BIT3_A,
+	0x60: execCB_BIT4_B,
+	0x61: execCB_BIT4_C,
+	0x62: execCB_BIT4_D,
+	0x63: execCB_BIT4_E,
+	0x64: execCB_BIT4_H,
+	0x65: execCB_BIT4_L,
+	0x66: execCB_BIT4_indHL,
+	0x67: execCB_BIT4_A,
+	0x68: execCB_BIT5_B,
+	0x69: execCB_BIT5_C,
+	0x6A: execCB_BIT5_D,
+	0x6B: execCB_BIT5_E,
+	0x6C: execCB_BIT5_H,
+	0x6D: execCB_BIT5_L,
+	0x6E: execCB_BIT5_indHL,
+	0x6F: execCB_BIT5_A,
+	0x70: execCB_BIT6_B,
+	0x71: execCB_BIT6_C,
+	0x72: execCB_BIT6_D,
+	0x73: execCB_BIT6_E,
+	0x74: execCB_BIT6_H,
+	0x75: execCB_BIT6_L,
+	0x76: execCB_BIT6_indHL,
+	0x77: execCB_BIT6_A,
+	0x78: execCB_BIT7_B,
+	0x79: execCB_BIT7_C,
+	0x7A: execCB_BIT7_D,
+	0x7B: execCB_BIT7_E,
+	0x7C: execCB_BIT7_H,
+	0x7D: execCB_BIT7_L,
+	0x7E: execCB_BIT7_indHL,
+	0x7F: execCB_BIT7_A,
+	0x80: execCB_RES0_B,
+	0x81: execCB_RES0_C,
+	0x82: execCB_RES0_D,
+	0x83: execCB_RES0_E,
+	0x84: execCB_RES0_H,
+	0x85: execCB_RES0_L,
+	0x86: execCB_RES0_indHL,
+	0x87: execCB_RES0_A,
+	0x88: execCB_RES1_B,
+	0x89: execCB_RES1_C,
+	0x8A: execCB_RES1_D,
+	0x8B: execCB_RES1_E,
+	0x8C: execCB_RES1_H,
+	0x8D: execCB_RES1_L,
+	0x8E: execCB_RES1_indHL,
+	0x8F: execCB_RES1_A,
+	0x90: execCB_RES2_B,
+	0x91: execCB_RES2_C,
+	0x92: execCB_RES2_D,
+	0x93: execCB_RES2_E,
+	0x94: execCB_RES2_H,
+	0x95: execCB_RES2_L,
+	0x96: execCB_RES2_indHL,
+	0x97: execCB_RES2_A,
+	0x98: execCB_RES3_B,
+	0x99: execCB_RES3_C,
+	0x9A: execCB_RES3_D,
+	0x9B: execCB_RES3_E,
+	0x9C: execCB_RES3_H,
+	0x9D: execCB_RES3_L,
+	0x9E: execCB_RES3_indHL,
+	0x9F: execCB_RES3_A,
+	0xA0: execCB_RES4_B,
+	0xA1: execCB_RES4_C,
+	0xA2: execCB_RES4_D,
+	0xA3: execCB_RES4_E,
+	0xA4: execCB_RES4_H,
+	0xA5: execCB_RES4_L,
+	0xA6: execCB_RES4_indHL,
+	0xA7: execCB_RES4_A,
+	0xA8: execCB_RES5_B,
+	0xA9: execCB_RES5_C,
+	0xAA: execCB_RES5_D,
+	0xAB: execCB_RES5_E,
+	0xAC: execCB_RES5_H,
+	0xAD: execCB_RES5_L,
+	0xAE: execCB_RES5_indHL,
+	0xAF: execCB_RES5_A,
+	0xB0: execCB_RES6_B,
+	0xB1: execCB_RES6_C,
+	0xB2: execCB_RES6_D,
+	0xB3: execCB_RES6_E,
+	0xB4: execCB_RES6_H,
+	0xB5: execCB_RES6_L,
+	0xB6: execCB_RES6_indHL,
+	0xB7: execCB_RES6_A,
+	0xB8: execCB_RES7_B,
+	0xB9: execCB_RES7_C,
+	0xBA: execCB_RES7_D,
+	0xBB: execCB_RES7_E,
+	0xBC: execCB_RES7_H,
+	0xBD: execCB_RES7_L,
+	0xBE: execCB_RES7_indHL,
+	0xBF: execCB_RES7_A,
+	0xC0: execCB_SET0_B,
+	0xC1: execCB_SET0_C,
+	0xC2: execCB_SET0_D,
+	0xC3: execCB_SET0_E,
+	0xC4: execCB_SET0_H,
+	0xC5: execCB_SET0_L,
+	0xC6: execCB_SET0_indHL,
+	0xC7: execCB_SET0_A,
+	0xC8: execCB_SET1_B,
+	0xC9: execCB_SET1_C,
+	0xCA: execCB_SET1_D,
+	0xCB: execCB_SET1_E,
+	0xCC: execCB_SET1_H,
+	0xCD: execCB_SET1_L,
+	0xCE: execCB_SET1_indHL,
+	0xCF: execCB_SET1_A,
+	0xD0: execCB_SET2_B,
+	0xD1: execCB_SET2_C,
+	0xD2: execCB_SET2_D,
+	0xD3: execCB_SET2_E,
+	0xD4: execCB_SET2_H,
+	0xD5: execCB_SET2_L,
+	0xD6: execCB_SET2_indHL,
+	0xD7: execCB_SET2_A,
+	0xD8: execCB_SET3_B,
+	0xD9: execCB_SET3_C,
+	0xDA: execCB_SET3_D,
+	0xDB: execCB_SET3_E,
+	0xDC: execCB_SET3_H,
+	0xDD: execCB_SET3_L,
+	0xDE: execCB_SET3_indHL,
+	0xDF: execCB_SET3_A,
+	0xE0: execCB_SET4_B,
+	0xE1: execCB_SET4_C,
+	0xE2: execCB_SET4_D,
+	0xE3: execCB_SET4_E,
+	0xE4: execCB_SET4_H,
+	0xE5: execCB_SET4_L,
+	0xE6: execCB_SET4_indHL,
+	0xE7: execCB_SET4_A,
+	0xE8: execCB_SET5_B,
+	0xE9: execCB_SET5_C,
+	0xEA: execCB_SET5_D,
+	0xEB: execCB_SET5_E,
+	0xEC: execCB_SET5_H,
+	0xED: execCB_SET5_L,
+	0xEE: execCB_SET5_indHL,
+	0xEF: execCB_SET5_A,
+	0xF0: execCB_SET6_B,
+	0xF1: execCB_SET6_C,
+	0xF2: execCB_SET6_D,
+	0xF3: execCB_SET6_E,
+	0xF4: execCB_SET6_H,
+	0xF5: execCB_SET6_L,
+	0xF6: execCB_SET6_indHL,
+	0xF7: execCB_SET6_A,
+	0xF8: execCB_SET7_B,
+	0xF9: execCB_SET7_C,
+	0xFA: execCB_SET7_D,
+	0xFB: execCB_SET7_E,
+	0xFC: execCB_SET7_H,
+	0xFD: execCB_SET7_L,
+	0xFE: execCB_SET7_indHL,
+	0xFF: execCB_SET7_A,
+}
+
+// execCB_RLC_B executes RLC B.
+func execCB_RLC_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B, cpu.Registers.F = alu.RLC(cpu.Registers.B)
+}
+
+// execCB_RLC_C executes RLC C.
+func execCB_RLC_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C, cpu.Registers.F = alu.RLC(cpu.Registers.C)
+}
+
+// execCB_RLC_D executes RLC D.
+func execCB_RLC_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D, cpu.Registers.F = alu.RLC(cpu.Registers.D)
+}
+
+// execCB_RLC_E executes RLC E.
+func execCB_RLC_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E, cpu.Registers.F = alu.RLC(cpu.Registers.E)
+}
+
+// execCB_RLC_H executes RLC H.
+func execCB_RLC_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H, cpu.Registers.F = alu.RLC(cpu.Registers.H)
+}
+
+// execCB_RLC_L executes RLC L.
+func execCB_RLC_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L, cpu.Registers.F = alu.RLC(cpu.Registers.L)
+}
+
+// execCB_RLC_indHL executes RLC (HL).
+func execCB_RLC_indHL(cpu *CPU, cycles *int) {
+	result, flags := alu.RLC(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+	cpu.WriteByteToMemory(cycles, cpu.HL(), result)
+	cpu.Registers.F = flags
+}
+
+// execCB_RLC_A executes RLC A.
+func execCB_RLC_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A, cpu.Registers.F = alu.RLC(cpu.Registers.A)
+}
+
+// execCB_RRC_B executes RRC B.
+func execCB_RRC_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B, cpu.Registers.F = alu.RRC(cpu.Registers.B)
+}
+
+// execCB_RRC_C executes RRC C.
+func execCB_RRC_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C, cpu.Registers.F = alu.RRC(cpu.Registers.C)
+}
+
+// execCB_RRC_D executes RRC D.
+func execCB_RRC_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D, cpu.Registers.F = alu.RRC(cpu.Registers.D)
+}
+
+// execCB_RRC_E executes RRC E.
+func execCB_RRC_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E, cpu.Registers.F = alu.RRC(cpu.Registers.E)
+}
+
+// execCB_RRC_H executes RRC H.
+func execCB_RRC_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H, cpu.Registers.F = alu.RRC(cpu.Registers.H)
+}
+
+// execCB_RRC_L executes RRC L.
+func execCB_RRC_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L, cpu.Registers.F = alu.RRC(cpu.Registers.L)
+}
+
+// execCB_RRC_indHL executes RRC (HL).
+func execCB_RRC_indHL(cpu *CPU, cycles *int) {
+	result, flags := alu.RRC(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+	cpu.WriteByteToMemory(cycles, cpu.HL(), result)
+	cpu.Registers.F = flags
+}
+
+// execCB_RRC_A executes RRC A.
+func execCB_RRC_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A, cpu.Registers.F = alu.RRC(cpu.Registers.A)
+}
+
+// execCB_RL_B executes RL B.
+func execCB_RL_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B, cpu.Registers.F = alu.RL(cpu.Registers.B, cpu.Registers.F)
+}
+
+// execCB_RL_C executes RL C.
+func execCB_RL_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C, cpu.Registers.F = alu.RL(cpu.Registers.C, cpu.Registers.F)
+}
+
+// execCB_RL_D executes RL D.
+func execCB_RL_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D, cpu.Registers.F = alu.RL(cpu.Registers.D, cpu.Registers.F)
+}
+
+// execCB_RL_E executes RL E.
+func execCB_RL_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E, cpu.Registers.F = alu.RL(cpu.Registers.E, cpu.Registers.F)
+}
+
+// execCB_RL_H executes RL H.
+func execCB_RL_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H, cpu.Registers.F = alu.RL(cpu.Registers.H, cpu.Registers.F)
+}
+
+// execCB_RL_L executes RL L.
+func execCB_RL_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L, cpu.Registers.F = alu.RL(cpu.Registers.L, cpu.Registers.F)
+}
+
+// execCB_RL_indHL executes RL (HL).
+func execCB_RL_indHL(cpu *CPU, cycles *int) {
+	result, flags := alu.RL(cpu.ReadByteFromMemory(cycles, cpu.HL()), cpu.Registers.F)
+	cpu.WriteByteToMemory(cycles, cpu.HL(), result)
+	cpu.Registers.F = flags
+}
+
+// execCB_RL_A executes RL A.
+func execCB_RL_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A, cpu.Registers.F = alu.RL(cpu.Registers.A, cpu.Registers.F)
+}
+
+// execCB_RR_B executes RR B.
+func execCB_RR_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B, cpu.Registers.F = alu.RR(cpu.Registers.B, cpu.Registers.F)
+}
+
+// execCB_RR_C executes RR C.
+func execCB_RR_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C, cpu.Registers.F = alu.RR(cpu.Registers.C, cpu.Registers.F)
+}
+
+// execCB_RR_D executes RR D.
+func execCB_RR_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D, cpu.Registers.F = alu.RR(cpu.Registers.D, cpu.Registers.F)
+}
+
+// execCB_RR_E executes RR E.
+func execCB_RR_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E, cpu.Registers.F = alu.RR(cpu.Registers.E, cpu.Registers.F)
+}
+
+// execCB_RR_H executes RR H.
+func execCB_RR_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H, cpu.Registers.F = alu.RR(cpu.Registers.H, cpu.Registers.F)
+}
+
+// execCB_RR_L executes RR L.
+func execCB_RR_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L, cpu.Registers.F = alu.RR(cpu.Registers.L, cpu.Registers.F)
+}
+
+// execCB_RR_indHL executes RR (HL).
+func execCB_RR_indHL(cpu *CPU, cycles *int) {
+	result, flags := alu.RR(cpu.ReadByteFromMemory(cycles, cpu.HL()), cpu.Registers.F)
+	cpu.WriteByteToMemory(cycles, cpu.HL(), result)
+	cpu.Registers.F = flags
+}
+
+// execCB_RR_A executes RR A.
+func execCB_RR_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A, cpu.Registers.F = alu.RR(cpu.Registers.A, cpu.Registers.F)
+}
+
+// execCB_SLA_B executes SLA B.
+func execCB_SLA_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B, cpu.Registers.F = alu.SLA(cpu.Registers.B)
+}
+
+// execCB_SLA_C executes SLA C.
+func execCB_SLA_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C, cpu.Registers.F = alu.SLA(cpu.Registers.C)
+}
+
+// execCB_SLA_D executes SLA D.
+func execCB_SLA_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D, cpu.Registers.F = alu.SLA(cpu.Registers.D)
+}
+
+// execCB_SLA_E executes SLA E.
+func execCB_SLA_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E, cpu.Registers.F = alu.SLA(cpu.Registers.E)
+}
+
+// execCB_SLA_H executes SLA H.
+func execCB_SLA_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H, cpu.Registers.F = alu.SLA(cpu.Registers.H)
+}
+
+// execCB_SLA_L executes SLA L.
+func execCB_SLA_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L, cpu.Registers.F = alu.SLA(cpu.Registers.L)
+}
+
+// execCB_SLA_indHL executes SLA (HL).
+func execCB_SLA_indHL(cpu *CPU, cycles *int) {
+	result, flags := alu.SLA(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+	cpu.WriteByteToMemory(cycles, cpu.HL(), result)
+	cpu.Registers.F = flags
+}
+
+// execCB_SLA_A executes SLA A.
+func execCB_SLA_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A, cpu.Registers.F = alu.SLA(cpu.Registers.A)
+}
+
+// execCB_SRA_B executes SRA B.
+func execCB_SRA_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B, cpu.Registers.F = alu.SRA(cpu.Registers.B)
+}
+
+// execCB_SRA_C executes SRA C.
+func execCB_SRA_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C, cpu.Registers.F = alu.SRA(cpu.Registers.C)
+}
+
+// execCB_SRA_D executes SRA D.
+func execCB_SRA_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D, cpu.Registers.F = alu.SRA(cpu.Registers.D)
+}
+
+// execCB_SRA_E executes SRA E.
+func execCB_SRA_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E, cpu.Registers.F = alu.SRA(cpu.Registers.E)
+}
+
+// execCB_SRA_H executes SRA H.
+func execCB_SRA_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H, cpu.Registers.F = alu.SRA(cpu.Registers.H)
+}
+
+// execCB_SRA_L executes SRA L.
+func execCB_SRA_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L, cpu.Registers.F = alu.SRA(cpu.Registers.L)
+}
+
+// execCB_SRA_indHL executes SRA (HL).
+func execCB_SRA_indHL(cpu *CPU, cycles *int) {
+	result, flags := alu.SRA(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+	cpu.WriteByteToMemory(cycles, cpu.HL(), result)
+	cpu.Registers.F = flags
+}
+
+// execCB_SRA_A executes SRA A.
+func execCB_SRA_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A, cpu.Registers.F = alu.SRA(cpu.Registers.A)
+}
+
+// execCB_SWAP_B executes SWAP B.
+func execCB_SWAP_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B, cpu.Registers.F = alu.SWAP(cpu.Registers.B)
+}
+
+// execCB_SWAP_C executes SWAP C.
+func execCB_SWAP_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C, cpu.Registers.F = alu.SWAP(cpu.Registers.C)
+}
+
+// execCB_SWAP_D executes SWAP D.
+func execCB_SWAP_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D, cpu.Registers.F = alu.SWAP(cpu.Registers.D)
+}
+
+// execCB_SWAP_E executes SWAP E.
+func execCB_SWAP_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E, cpu.Registers.F = alu.SWAP(cpu.Registers.E)
+}
+
+// execCB_SWAP_H executes SWAP H.
+func execCB_SWAP_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H, cpu.Registers.F = alu.SWAP(cpu.Registers.H)
+}
+
+// execCB_SWAP_L executes SWAP L.
+func execCB_SWAP_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L, cpu.Registers.F = alu.SWAP(cpu.Registers.L)
+}
+
+// execCB_SWAP_indHL executes SWAP (HL).
+func execCB_SWAP_indHL(cpu *CPU, cycles *int) {
+	result, flags := alu.SWAP(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+	cpu.WriteByteToMemory(cycles, cpu.HL(), result)
+	cpu.Registers.F = flags
+}
+
+// execCB_SWAP_A executes SWAP A.
+func execCB_SWAP_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A, cpu.Registers.F = alu.SWAP(cpu.Registers.A)
+}
+
+// execCB_SRL_B executes SRL B.
+func execCB_SRL_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B, cpu.Registers.F = alu.SRL(cpu.Registers.B)
+}
+
+// execCB_SRL_C executes SRL C.
+func execCB_SRL_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C, cpu.Registers.F = alu.SRL(cpu.Registers.C)
+}
+
+// execCB_SRL_D executes SRL D.
+func execCB_SRL_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D, cpu.Registers.F = alu.SRL(cpu.Registers.D)
+}
+
+// execCB_SRL_E executes SRL E.
+func execCB_SRL_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E, cpu.Registers.F = alu.SRL(cpu.Registers.E)
+}
+
+// execCB_SRL_H executes SRL H.
+func execCB_SRL_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H, cpu.Registers.F = alu.SRL(cpu.Registers.H)
+}
+
+// execCB_SRL_L executes SRL L.
+func execCB_SRL_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L, cpu.Registers.F = alu.SRL(cpu.Registers.L)
+}
+
+// execCB_SRL_indHL executes SRL (HL).
+func execCB_SRL_indHL(cpu *CPU, cycles *int) {
+	result, flags := alu.SRL(cpu.ReadByteFromMemory(cycles, cpu.HL()))
+	cpu.WriteByteToMemory(cycles, cpu.HL(), result)
+	cpu.Registers.F = flags
+}
+
+// execCB_SRL_A executes SRL A.
+func execCB_SRL_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A, cpu.Registers.F = alu.SRL(cpu.Registers.A)
+}
+
+// execCB_BIT0_B executes BIT 0, B.
+func execCB_BIT0_B(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(0, cpu.Registers.B, cpu.Registers.F)
+}
+
+// execCB_BIT0_C executes BIT 0, C.
+func execCB_BIT0_C(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(0, cpu.Registers.C, cpu.Registers.F)
+}
+
+// execCB_BIT0_D executes BIT 0, D.
+func execCB_BIT0_D(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(0, cpu.Registers.D, cpu.Registers.F)
+}
+
+// execCB_BIT0_E executes BIT 0, E.
+func execCB_BIT0_E(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(0, cpu.Registers.E, cpu.Registers.F)
+}
+
+// execCB_BIT0_H executes BIT 0, H.
+func execCB_BIT0_H(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(0, cpu.Registers.H, cpu.Registers.F)
+}
+
+// execCB_BIT0_L executes BIT 0, L.
+func execCB_BIT0_L(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(0, cpu.Registers.L, cpu.Registers.F)
+}
+
+// execCB_BIT0_indHL executes BIT 0, (HL).
+func execCB_BIT0_indHL(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(0, cpu.ReadByteFromMemory(cycles, cpu.HL()), cpu.Registers.F)
+}
+
+// execCB_BIT0_A executes BIT 0, A.
+func execCB_BIT0_A(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(0, cpu.Registers.A, cpu.Registers.F)
+}
+
+// execCB_BIT1_B executes BIT 1, B.
+func execCB_BIT1_B(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(1, cpu.Registers.B, cpu.Registers.F)
+}
+
+// execCB_BIT1_C executes BIT 1, C.
+func execCB_BIT1_C(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(1, cpu.Registers.C, cpu.Registers.F)
+}
+
+// execCB_BIT1_D executes BIT 1, D.
+func execCB_BIT1_D(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(1, cpu.Registers.D, cpu.Registers.F)
+}
+
+// execCB_BIT1_E executes BIT 1, E.
+func execCB_BIT1_E(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(1, cpu.Registers.E, cpu.Registers.F)
+}
+
+// execCB_BIT1_H executes BIT 1, H.
+func execCB_BIT1_H(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(1, cpu.Registers.H, cpu.Registers.F)
+}
+
+// execCB_BIT1_L executes BIT 1, L.
+func execCB_BIT1_L(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(1, cpu.Registers.L, cpu.Registers.F)
+}
+
+// execCB_BIT1_indHL executes BIT 1, (HL).
+func execCB_BIT1_indHL(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(1, cpu.ReadByteFromMemory(cycles, cpu.HL()), cpu.Registers.F)
+}
+
+// execCB_BIT1_A executes BIT 1, A.
+func execCB_BIT1_A(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(1, cpu.Registers.A, cpu.Registers.F)
+}
+
+// execCB_BIT2_B executes BIT 2, B.
+func execCB_BIT2_B(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(2, cpu.Registers.B, cpu.Registers.F)
+}
+
+// execCB_BIT2_C executes BIT 2, C.
+func execCB_BIT2_C(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(2, cpu.Registers.C, cpu.Registers.F)
+}
+
+// execCB_BIT2_D executes BIT 2, D.
+func execCB_BIT2_D(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(2, cpu.Registers.D, cpu.Registers.F)
+}
+
+// execCB_BIT2_E executes BIT 2, E.
+func execCB_BIT2_E(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(2, cpu.Registers.E, cpu.Registers.F)
+}
+
+// execCB_BIT2_H executes BIT 2, H.
+func execCB_BIT2_H(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(2, cpu.Registers.H, cpu.Registers.F)
+}
+
+// execCB_BIT2_L executes BIT 2, L.
+func execCB_BIT2_L(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(2, cpu.Registers.L, cpu.Registers.F)
+}
+
+// execCB_BIT2_indHL executes BIT 2, (HL).
+func execCB_BIT2_indHL(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(2, cpu.ReadByteFromMemory(cycles, cpu.HL()), cpu.Registers.F)
+}
+
+// execCB_BIT2_A executes BIT 2, A.
+func execCB_BIT2_A(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(2, cpu.Registers.A, cpu.Registers.F)
+}
+
+// execCB_BIT3_B executes BIT 3, B.
+func execCB_BIT3_B(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(3, cpu.Registers.B, cpu.Registers.F)
+}
+
+// execCB_BIT3_C executes BIT 3, C.
+func execCB_BIT3_C(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(3, cpu.Registers.C, cpu.Registers.F)
+}
+
+// execCB_BIT3_D executes BIT 3, D.
+func execCB_BIT3_D(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(3, cpu.Registers.D, cpu.Registers.F)
+}
+
+// execCB_BIT3_E executes BIT 3, E.
+func execCB_BIT3_E(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(3, cpu.Registers.E, cpu.Registers.F)
+}
+
+// execCB_BIT3_H executes BIT 3, H.
+func execCB_BIT3_H(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(3, cpu.Registers.H, cpu.Registers.F)
+}
+
+// execCB_BIT3_L executes BIT 3, L.
+func execCB_BIT3_L(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(3, cpu.Registers.L, cpu.Registers.F)
+}
+
+// execCB_BIT3_indHL executes BIT 3, (HL).
+func execCB_BIT3_indHL(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(3, cpu.ReadByteFromMemory(cycles, cpu.HL()), cpu.Registers.F)
+}
+
+// execCB_BIT3_A executes BIT 3, A.
+func execCB_BIT3_A(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(3, cpu.Registers.A, cpu.Registers.F)
+}
+
+// execCB_BIT4_B executes BIT 4, B.
+func execCB_BIT4_B(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(4, cpu.Registers.B, cpu.Registers.F)
+}
+
+// execCB_BIT4_C executes BIT 4, C.
+func execCB_BIT4_C(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(4, cpu.Registers.C, cpu.Registers.F)
+}
+
+// execCB_BIT4_D executes BIT 4, D.
+func execCB_BIT4_D(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(4, cpu.Registers.D, cpu.Registers.F)
+}
+
+// execCB_BIT4_E executes BIT 4, E.
+func execCB_BIT4_E(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(4, cpu.Registers.E, cpu.Registers.F)
+}
+
+// execCB_BIT4_H executes BIT 4, H.
+func execCB_BIT4_H(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(4, cpu.Registers.H, cpu.Registers.F)
+}
+
+// execCB_BIT4_L executes BIT 4, L.
+func execCB_BIT4_L(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(4, cpu.Registers.L, cpu.Registers.F)
+}
+
+// execCB_BIT4_indHL executes BIT 4, (HL).
+func execCB_BIT4_indHL(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(4, cpu.ReadByteFromMemory(cycles, cpu.HL()), cpu.Registers.F)
+}
+
+// execCB_BIT4_A executes BIT 4, A.
+func execCB_BIT4_A(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(4, cpu.Registers.A, cpu.Registers.F)
+}
+
+// execCB_BIT5_B executes BIT 5, B.
+func execCB_BIT5_B(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(5, cpu.Registers.B, cpu.Registers.F)
+}
+
+// execCB_BIT5_C executes BIT 5, C.
+func execCB_BIT5_C(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(5, cpu.Registers.C, cpu.Registers.F)
+}
+
+// execCB_BIT5_D executes BIT 5, D.
+func execCB_BIT5_D(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(5, cpu.Registers.D, cpu.Registers.F)
+}
+
+// execCB_BIT5_E executes BIT 5, E.
+func execCB_BIT5_E(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(5, cpu.Registers.E, cpu.Registers.F)
+}
+
+// execCB_BIT5_H executes BIT 5, H.
+func execCB_BIT5_H(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(5, cpu.Registers.H, cpu.Registers.F)
+}
+
+// execCB_BIT5_L executes BIT 5, L.
+func execCB_BIT5_L(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(5, cpu.Registers.L, cpu.Registers.F)
+}
+
+// execCB_BIT5_indHL executes BIT 5, (HL).
+func execCB_BIT5_indHL(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(5, cpu.ReadByteFromMemory(cycles, cpu.HL()), cpu.Registers.F)
+}
+
+// execCB_BIT5_A executes BIT 5, A.
+func execCB_BIT5_A(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(5, cpu.Registers.A, cpu.Registers.F)
+}
+
+// execCB_BIT6_B executes BIT 6, B.
+func execCB_BIT6_B(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(6, cpu.Registers.B, cpu.Registers.F)
+}
+
+// execCB_BIT6_C executes BIT 6, C.
+func execCB_BIT6_C(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(6, cpu.Registers.C, cpu.Registers.F)
+}
+
+// execCB_BIT6_D executes BIT 6, D.
+func execCB_BIT6_D(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(6, cpu.Registers.D, cpu.Registers.F)
+}
+
+// execCB_BIT6_E executes BIT 6, E.
+func execCB_BIT6_E(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(6, cpu.Registers.E, cpu.Registers.F)
+}
+
+// execCB_BIT6_H executes BIT 6, H.
+func execCB_BIT6_H(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(6, cpu.Registers.H, cpu.Registers.F)
+}
+
+// execCB_BIT6_L executes BIT 6, L.
+func execCB_BIT6_L(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(6, cpu.Registers.L, cpu.Registers.F)
+}
+
+// execCB_BIT6_indHL executes BIT 6, (HL).
+func execCB_BIT6_indHL(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(6, cpu.ReadByteFromMemory(cycles, cpu.HL()), cpu.Registers.F)
+}
+
+// execCB_BIT6_A executes BIT 6, A.
+func execCB_BIT6_A(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(6, cpu.Registers.A, cpu.Registers.F)
+}
+
+// execCB_BIT7_B executes BIT 7, B.
+func execCB_BIT7_B(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(7, cpu.Registers.B, cpu.Registers.F)
+}
+
+// execCB_BIT7_C executes BIT 7, C.
+func execCB_BIT7_C(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(7, cpu.Registers.C, cpu.Registers.F)
+}
+
+// execCB_BIT7_D executes BIT 7, D.
+func execCB_BIT7_D(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(7, cpu.Registers.D, cpu.Registers.F)
+}
+
+// execCB_BIT7_E executes BIT 7, E.
+func execCB_BIT7_E(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(7, cpu.Registers.E, cpu.Registers.F)
+}
+
+// execCB_BIT7_H executes BIT 7, H.
+func execCB_BIT7_H(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(7, cpu.Registers.H, cpu.Registers.F)
+}
+
+// execCB_BIT7_L executes BIT 7, L.
+func execCB_BIT7_L(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(7, cpu.Registers.L, cpu.Registers.F)
+}
+
+// execCB_BIT7_indHL executes BIT 7, (HL).
+func execCB_BIT7_indHL(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(7, cpu.ReadByteFromMemory(cycles, cpu.HL()), cpu.Registers.F)
+}
+
+// execCB_BIT7_A executes BIT 7, A.
+func execCB_BIT7_A(cpu *CPU, cycles *int) {
+	cpu.Registers.F = alu.Bit(7, cpu.Registers.A, cpu.Registers.F)
+}
+
+// execCB_RES0_B executes RES 0, B.
+func execCB_RES0_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B &^ (1 << 0)
+}
+
+// execCB_RES0_C executes RES 0, C.
+func execCB_RES0_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C &^ (1 << 0)
+}
+
+// execCB_RES0_D executes RES 0, D.
+func execCB_RES0_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D &^ (1 << 0)
+}
+
+// execCB_RES0_E executes RES 0, E.
+func execCB_RES0_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E &^ (1 << 0)
+}
+
+// execCB_RES0_H executes RES 0, H.
+func execCB_RES0_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H &^ (1 << 0)
+}
+
+// execCB_RES0_L executes RES 0, L.
+func execCB_RES0_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L &^ (1 << 0)
+}
+
+// execCB_RES0_indHL executes RES 0, (HL).
+func execCB_RES0_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value&^(1<<0))
+}
+
+// execCB_RES0_A executes RES 0, A.
+func execCB_RES0_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A &^ (1 << 0)
+}
+
+// execCB_RES1_B executes RES 1, B.
+func execCB_RES1_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B &^ (1 << 1)
+}
+
+// execCB_RES1_C executes RES 1, C.
+func execCB_RES1_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C &^ (1 << 1)
+}
+
+// execCB_RES1_D executes RES 1, D.
+func execCB_RES1_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D &^ (1 << 1)
+}
+
+// execCB_RES1_E executes RES 1, E.
+func execCB_RES1_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E &^ (1 << 1)
+}
+
+// execCB_RES1_H executes RES 1, H.
+func execCB_RES1_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H &^ (1 << 1)
+}
+
+// execCB_RES1_L executes RES 1, L.
+func execCB_RES1_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L &^ (1 << 1)
+}
+
+// execCB_RES1_indHL executes RES 1, (HL).
+func execCB_RES1_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value&^(1<<1))
+}
+
+// execCB_RES1_A executes RES 1, A.
+func execCB_RES1_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A &^ (1 << 1)
+}
+
+// execCB_RES2_B executes RES 2, B.
+func execCB_RES2_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B &^ (1 << 2)
+}
+
+// execCB_RES2_C executes RES 2, C.
+func execCB_RES2_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C &^ (1 << 2)
+}
+
+// execCB_RES2_D executes RES 2, D.
+func execCB_RES2_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D &^ (1 << 2)
+}
+
+// execCB_RES2_E executes RES 2, E.
+func execCB_RES2_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E &^ (1 << 2)
+}
+
+// execCB_RES2_H executes RES 2, H.
+func execCB_RES2_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H &^ (1 << 2)
+}
+
+// execCB_RES2_L executes RES 2, L.
+func execCB_RES2_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L &^ (1 << 2)
+}
+
+// execCB_RES2_indHL executes RES 2, (HL).
+func execCB_RES2_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value&^(1<<2))
+}
+
+// execCB_RES2_A executes RES 2, A.
+func execCB_RES2_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A &^ (1 << 2)
+}
+
+// execCB_RES3_B executes RES 3, B.
+func execCB_RES3_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B &^ (1 << 3)
+}
+
+// execCB_RES3_C executes RES 3, C.
+func execCB_RES3_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C &^ (1 << 3)
+}
+
+// execCB_RES3_D executes RES 3, D.
+func execCB_RES3_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D &^ (1 << 3)
+}
+
+// execCB_RES3_E executes RES 3, E.
+func execCB_RES3_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E &^ (1 << 3)
+}
+
+// execCB_RES3_H executes RES 3, H.
+func execCB_RES3_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H &^ (1 << 3)
+}
+
+// execCB_RES3_L executes RES 3, L.
+func execCB_RES3_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L &^ (1 << 3)
+}
+
+// execCB_RES3_indHL executes RES 3, (HL).
+func execCB_RES3_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value&^(1<<3))
+}
+
+// execCB_RES3_A executes RES 3, A.
+func execCB_RES3_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A &^ (1 << 3)
+}
+
+// execCB_RES4_B executes RES 4, B.
+func execCB_RES4_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B &^ (1 << 4)
+}
+
+// execCB_RES4_C executes RES 4, C.
+func execCB_RES4_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C &^ (1 << 4)
+}
+
+// execCB_RES4_D executes RES 4, D.
+func execCB_RES4_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D &^ (1 << 4)
+}
+
+// execCB_RES4_E executes RES 4, E.
+func execCB_RES4_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E &^ (1 << 4)
+}
+
+// execCB_RES4_H executes RES 4, H.
+func execCB_RES4_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H &^ (1 << 4)
+}
+
+// execCB_RES4_L executes RES 4, L.
+func execCB_RES4_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L &^ (1 << 4)
+}
+
+// execCB_RES4_indHL executes RES 4, (HL).
+func execCB_RES4_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value&^(1<<4))
+}
+
+// execCB_RES4_A executes RES 4, A.
+func execCB_RES4_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A &^ (1 << 4)
+}
+
+// execCB_RES5_B executes RES 5, B.
+func execCB_RES5_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B &^ (1 << 5)
+}
+
+// execCB_RES5_C executes RES 5, C.
+func execCB_RES5_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C &^ (1 << 5)
+}
+
+// execCB_RES5_D executes RES 5, D.
+func execCB_RES5_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D &^ (1 << 5)
+}
+
+// execCB_RES5_E executes RES 5, E.
+func execCB_RES5_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E &^ (1 << 5)
+}
+
+// execCB_RES5_H executes RES 5, H.
+func execCB_RES5_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H &^ (1 << 5)
+}
+
+// execCB_RES5_L executes RES 5, L.
+func execCB_RES5_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L &^ (1 << 5)
+}
+
+// execCB_RES5_indHL executes RES 5, (HL).
+func execCB_RES5_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value&^(1<<5))
+}
+
+// execCB_RES5_A executes RES 5, A.
+func execCB_RES5_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A &^ (1 << 5)
+}
+
+// execCB_RES6_B executes RES 6, B.
+func execCB_RES6_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B &^ (1 << 6)
+}
+
+// execCB_RES6_C executes RES 6, C.
+func execCB_RES6_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C &^ (1 << 6)
+}
+
+// execCB_RES6_D executes RES 6, D.
+func execCB_RES6_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D &^ (1 << 6)
+}
+
+// execCB_RES6_E executes RES 6, E.
+func execCB_RES6_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E &^ (1 << 6)
+}
+
+// execCB_RES6_H executes RES 6, H.
+func execCB_RES6_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H &^ (1 << 6)
+}
+
+// execCB_RES6_L executes RES 6, L.
+func execCB_RES6_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L &^ (1 << 6)
+}
+
+// execCB_RES6_indHL executes RES 6, (HL).
+func execCB_RES6_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value&^(1<<6))
+}
+
+// execCB_RES6_A executes RES 6, A.
+func execCB_RES6_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A &^ (1 << 6)
+}
+
+// execCB_RES7_B executes RES 7, B.
+func execCB_RES7_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B &^ (1 << 7)
+}
+
+// execCB_RES7_C executes RES 7, C.
+func execCB_RES7_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C &^ (1 << 7)
+}
+
+// execCB_RES7_D executes RES 7, D.
+func execCB_RES7_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D &^ (1 << 7)
+}
+
+// execCB_RES7_E executes RES 7, E.
+func execCB_RES7_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E &^ (1 << 7)
+}
+
+// execCB_RES7_H executes RES 7, H.
+func execCB_RES7_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H &^ (1 << 7)
+}
+
+// execCB_RES7_L executes RES 7, L.
+func execCB_RES7_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L &^ (1 << 7)
+}
+
+// execCB_RES7_indHL executes RES 7, (HL).
+func execCB_RES7_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value&^(1<<7))
+}
+
+// execCB_RES7_A executes RES 7, A.
+func execCB_RES7_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A &^ (1 << 7)
+}
+
+// execCB_SET0_B executes SET 0, B.
+func execCB_SET0_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B | (1 << 0)
+}
+
+// execCB_SET0_C executes SET 0, C.
+func execCB_SET0_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C | (1 << 0)
+}
+
+// execCB_SET0_D executes SET 0, D.
+func execCB_SET0_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D | (1 << 0)
+}
+
+// execCB_SET0_E executes SET 0, E.
+func execCB_SET0_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E | (1 << 0)
+}
+
+// execCB_SET0_H executes SET 0, H.
+func execCB_SET0_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H | (1 << 0)
+}
+
+// execCB_SET0_L executes SET 0, L.
+func execCB_SET0_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L | (1 << 0)
+}
+
+// execCB_SET0_indHL executes SET 0, (HL).
+func execCB_SET0_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value|(1<<0))
+}
+
+// execCB_SET0_A executes SET 0, A.
+func execCB_SET0_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A | (1 << 0)
+}
+
+// execCB_SET1_B executes SET 1, B.
+func execCB_SET1_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B | (1 << 1)
+}
+
+// execCB_SET1_C executes SET 1, C.
+func execCB_SET1_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C | (1 << 1)
+}
+
+// execCB_SET1_D executes SET 1, D.
+func execCB_SET1_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D | (1 << 1)
+}
+
+// execCB_SET1_E executes SET 1, E.
+func execCB_SET1_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E | (1 << 1)
+}
+
+// execCB_SET1_H executes SET 1, H.
+func execCB_SET1_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H | (1 << 1)
+}
+
+// execCB_SET1_L executes SET 1, L.
+func execCB_SET1_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L | (1 << 1)
+}
+
+// execCB_SET1_indHL executes SET 1, (HL).
+func execCB_SET1_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value|(1<<1))
+}
+
+// execCB_SET1_A executes SET 1, A.
+func execCB_SET1_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A | (1 << 1)
+}
+
+// execCB_SET2_B executes SET 2, B.
+func execCB_SET2_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B | (1 << 2)
+}
+
+// execCB_SET2_C executes SET 2, C.
+func execCB_SET2_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C | (1 << 2)
+}
+
+// execCB_SET2_D executes SET 2, D.
+func execCB_SET2_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D | (1 << 2)
+}
+
+// execCB_SET2_E executes SET 2, E.
+func execCB_SET2_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E | (1 << 2)
+}
+
+// execCB_SET2_H executes SET 2, H.
+func execCB_SET2_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H | (1 << 2)
+}
+
+// execCB_SET2_L executes SET 2, L.
+func execCB_SET2_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L | (1 << 2)
+}
+
+// execCB_SET2_indHL executes SET 2, (HL).
+func execCB_SET2_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value|(1<<2))
+}
+
+// execCB_SET2_A executes SET 2, A.
+func execCB_SET2_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A | (1 << 2)
+}
+
+// execCB_SET3_B executes SET 3, B.
+func execCB_SET3_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B | (1 << 3)
+}
+
+// execCB_SET3_C executes SET 3, C.
+func execCB_SET3_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C | (1 << 3)
+}
+
+// execCB_SET3_D executes SET 3, D.
+func execCB_SET3_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D | (1 << 3)
+}
+
+// execCB_SET3_E executes SET 3, E.
+func execCB_SET3_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E | (1 << 3)
+}
+
+// execCB_SET3_H executes SET 3, H.
+func execCB_SET3_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H | (1 << 3)
+}
+
+// execCB_SET3_L executes SET 3, L.
+func execCB_SET3_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L | (1 << 3)
+}
+
+// execCB_SET3_indHL executes SET 3, (HL).
+func execCB_SET3_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value|(1<<3))
+}
+
+// execCB_SET3_A executes SET 3, A.
+func execCB_SET3_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A | (1 << 3)
+}
+
+// execCB_SET4_B executes SET 4, B.
+func execCB_SET4_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B | (1 << 4)
+}
+
+// execCB_SET4_C executes SET 4, C.
+func execCB_SET4_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C | (1 << 4)
+}
+
+// execCB_SET4_D executes SET 4, D.
+func execCB_SET4_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D | (1 << 4)
+}
+
+// execCB_SET4_E executes SET 4, E.
+func execCB_SET4_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E | (1 << 4)
+}
+
+// execCB_SET4_H executes SET 4, H.
+func execCB_SET4_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H | (1 << 4)
+}
+
+// execCB_SET4_L executes SET 4, L.
+func execCB_SET4_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L | (1 << 4)
+}
+
+// execCB_SET4_indHL executes SET 4, (HL).
+func execCB_SET4_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value|(1<<4))
+}
+
+// execCB_SET4_A executes SET 4, A.
+func execCB_SET4_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A | (1 << 4)
+}
+
+// execCB_SET5_B executes SET 5, B.
+func execCB_SET5_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B | (1 << 5)
+}
+
+// execCB_SET5_C executes SET 5, C.
+func execCB_SET5_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C | (1 << 5)
+}
+
+// execCB_SET5_D executes SET 5, D.
+func execCB_SET5_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D | (1 << 5)
+}
+
+// execCB_SET5_E executes SET 5, E.
+func execCB_SET5_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E | (1 << 5)
+}
+
+// execCB_SET5_H executes SET 5, H.
+func execCB_SET5_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H | (1 << 5)
+}
+
+// execCB_SET5_L executes SET 5, L.
+func execCB_SET5_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L | (1 << 5)
+}
+
+// execCB_SET5_indHL executes SET 5, (HL).
+func execCB_SET5_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value|(1<<5))
+}
+
+// execCB_SET5_A executes SET 5, A.
+func execCB_SET5_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A | (1 << 5)
+}
+
+// execCB_SET6_B executes SET 6, B.
+func execCB_SET6_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B | (1 << 6)
+}
+
+// execCB_SET6_C executes SET 6, C.
+func execCB_SET6_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C | (1 << 6)
+}
+
+// execCB_SET6_D executes SET 6, D.
+func execCB_SET6_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D | (1 << 6)
+}
+
+// execCB_SET6_E executes SET 6, E.
+func execCB_SET6_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E | (1 << 6)
+}
+
+// execCB_SET6_H executes SET 6, H.
+func execCB_SET6_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H | (1 << 6)
+}
+
+// execCB_SET6_L executes SET 6, L.
+func execCB_SET6_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L | (1 << 6)
+}
+
+// execCB_SET6_indHL executes SET 6, (HL).
+func execCB_SET6_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value|(1<<6))
+}
+
+// execCB_SET6_A executes SET 6, A.
+func execCB_SET6_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A | (1 << 6)
+}
+
+// execCB_SET7_B executes SET 7, B.
+func execCB_SET7_B(cpu *CPU, cycles *int) {
+	cpu.Registers.B = cpu.Registers.B | (1 << 7)
+}
+
+// execCB_SET7_C executes SET 7, C.
+func execCB_SET7_C(cpu *CPU, cycles *int) {
+	cpu.Registers.C = cpu.Registers.C | (1 << 7)
+}
+
+// execCB_SET7_D executes SET 7, D.
+func execCB_SET7_D(cpu *CPU, cycles *int) {
+	cpu.Registers.D = cpu.Registers.D | (1 << 7)
+}
+
+// execCB_SET7_E executes SET 7, E.
+func execCB_SET7_E(cpu *CPU, cycles *int) {
+	cpu.Registers.E = cpu.Registers.E | (1 << 7)
+}
+
+// execCB_SET7_H executes SET 7, H.
+func execCB_SET7_H(cpu *CPU, cycles *int) {
+	cpu.Registers.H = cpu.Registers.H | (1 << 7)
+}
+
+// execCB_SET7_L executes SET 7, L.
+func execCB_SET7_L(cpu *CPU, cycles *int) {
+	cpu.Registers.L = cpu.Registers.L | (1 << 7)
+}
+
+// execCB_SET7_indHL executes SET 7, (HL).
+func execCB_SET7_indHL(cpu *CPU, cycles *int) {
+	value := cpu.ReadByteFromMemory(cycles, cpu.HL())
+	cpu.WriteByteToMemory(cycles, cpu.HL(), value|(1<<7))
+}
+
+// execCB_SET7_A executes SET 7, A.
+func execCB_SET7_A(cpu *CPU, cycles *int) {
+	cpu.Registers.A = cpu.Registers.A | (1 << 7)
+}
@@ -0,0 +1,96 @@
+package arc
+
+// Interrupt identifies an interrupt source by its bit in the IF and IE registers.
+// Lower bits have higher priority.
+type Interrupt byte
+
+const (
+    VBlank  Interrupt = iota // Bit 0, vector 0x40.
+    LCDStat                  // Bit 1, vector 0x48.
+    Timer                    // Bit 2, vector 0x50.
+    Serial                   // Bit 3, vector 0x58.
+    Joypad                   // Bit 4, vector 0x60.
+)
+
+const (
+    // IF: Interrupt Flag, a bit is set when the corresponding interrupt is requested.
+    IFAddress = 0xFF0F
+
+    // IE: Interrupt Enable, a bit is set when the corresponding interrupt can be dispatched.
+    IEAddress = 0xFFFF
+
+    // Only the lower 5 bits of IF and IE are wired to interrupt sources.
+    interruptMask = 0x1F
+)
+
+// Vector returns the address the CPU jumps to when dispatching the interrupt.
+func (i Interrupt) Vector() uint16 {
+    return 0x40 + 8 * uint16(i)
+}
+
+// RequestInterrupt raises the interrupt line by setting its bit in IF.
+// Timer, PPU, serial and joypad use it to signal the CPU.
+func (cpu *CPU) RequestInterrupt(i Interrupt) {
+    cpu.Bus.Write(IFAddress, cpu.Bus.Read(IFAddress) | 1 << i)
+}
+
+// PendingInterrupts returns the interrupts that are both requested and enabled, IF & IE.
+func (cpu *CPU) PendingInterrupts() byte {
+    return cpu.Bus.Read(IFAddress) & cpu.Bus.Read(IEAddress) & interruptMask
+}
+
+// DispatchInterrupt services the highest priority pending interrupt: IME is cleared,
+// PC is pushed to the stack and the interrupt vector is loaded into PC.
+// It consumes 5 machine cycles: 2 wait cycles, W(msb), W(lsb), PC = vector.
+//
+// The interrupt to service is chosen after the MSB of PC has been pushed: if the push
+// overwrote IE (SP = 0x0000), another interrupt may be dispatched instead, or if none
+// is pending anymore, the dispatch is cancelled and PC is set to 0x0000.
+func (cpu *CPU) DispatchInterrupt(cycles *int) {
+
+    cpu.IME = false
+
+    // Two wait states, the second one also decrements SP.
+    cpu.tick(cycles)
+    cpu.tick(cycles)
+
+    cpu.PushToSP(cycles, byte(cpu.Registers.PC >> 8))
+
+    // IE is sampled here, between the two pushes.
+    pending := cpu.PendingInterrupts()
+
+    cpu.PushToSP(cycles, byte(cpu.Registers.PC & 0xFF))
+
+    cpu.Registers.PC = 0x0000
+    for i := VBlank; i <= Joypad; i++ {
+        if pending & (1 << i) != 0 {
+
+            // Acknowledge the request.
+            cpu.Bus.Write(IFAddress, cpu.Bus.Read(IFAddress) &^ (1 << i))
+            cpu.Registers.PC = i.Vector()
+            break
+        }
+    }
+    cpu.tick(cycles)
+}
+
+// EnableInterrupts executes EI: IME is set only after the instruction following EI, see step.
+// Cycles: 1 machine cycle.
+func (cpu *CPU) EnableInterrupts() {
+    cpu.imeScheduled = true
+}
+
+// DisableInterrupts executes DI: IME is cleared, and a scheduled EI is cancelled.
+// Cycles: 1 machine cycle.
+func (cpu *CPU) DisableInterrupts() {
+    cpu.IME = false
+    cpu.imeScheduled = false
+}
+
+// ReturnFromInterrupt executes RETI: returns from a function and, unlike EI, enables
+// interrupts immediately.
+// Cycles: 4 machine cycles. opcode, R(lsb), R(msb), PC = nn.
+func (cpu *CPU) ReturnFromInterrupt(cycles *int) {
+    cpu.Return(cycles)
+    cpu.IME = true
+}
@@ -0,0 +1,280 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"testing"
+)
+
+// TestInterruptDispatch verifies that a pending and enabled interrupt is serviced in 5 cycles.
+func TestInterruptDispatch(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = true
+    ram(cpu)[IEAddress] = 1 << Timer
+    cpu.RequestInterrupt(Timer)
+    initialSP := cpu.Registers.SP
+
+    // When
+    expectedCycles := 5
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0050 {
+        t.Error("PC should be 0x0050, instead got: ", cpu.Registers.PC)
+    }
+
+    if cpu.IME {
+        t.Error("IME should be cleared when dispatching an interrupt.")
+    }
+
+    if ram(cpu)[IFAddress] & (1 << Timer) != 0 {
+        t.Error("Timer request should be acknowledged in IF.")
+    }
+
+    if ram(cpu)[initialSP - 1] != 0x01 || ram(cpu)[initialSP - 2] != 0x00 {
+        t.Error("Return address 0x0100 should be pushed to the stack.")
+    }
+}
+
+// TestInterruptPriority verifies that the lowest requested bit is serviced first.
+func TestInterruptPriority(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = true
+    ram(cpu)[IEAddress] = 0x1F
+    cpu.RequestInterrupt(Joypad)
+    cpu.RequestInterrupt(Serial)
+    cpu.RequestInterrupt(LCDStat)
+
+    // When
+    cpu.Execute(5)
+
+    if cpu.Registers.PC != 0x0048 {
+        t.Error("PC should be 0x0048, instead got: ", cpu.Registers.PC)
+    }
+
+    if ram(cpu)[IFAddress] != 1 << Joypad | 1 << Serial {
+        t.Error("Only the LCD STAT request should be acknowledged, IF: ", ram(cpu)[IFAddress])
+    }
+}
+
+// TestInterruptNotDispatchedWhenDisabled verifies that requests are ignored while IME or IE are cleared.
+func TestInterruptNotDispatchedWhenDisabled(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = false
+    ram(cpu)[IEAddress] = 1 << VBlank
+    cpu.RequestInterrupt(VBlank)
+    ram(cpu)[0x0100] = instructions.LDB_B
+
+    // When
+    expectedCycles := 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0101 {
+        t.Error("PC should be 0x0101, instead got: ", cpu.Registers.PC)
+    }
+
+    // Enabled in IME, but not in IE.
+    cpu = InitSM83()
+    cpu.IME = true
+    ram(cpu)[IEAddress] = 1 << Serial
+    cpu.RequestInterrupt(VBlank)
+    ram(cpu)[0x0100] = instructions.LDB_B
+
+    cpu.Execute(1)
+
+    if cpu.Registers.PC != 0x0101 {
+        t.Error("PC should be 0x0101, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestEIDelay verifies that EI enables interrupts only after the following instruction.
+func TestEIDelay(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[IEAddress] = 1 << VBlank
+    cpu.RequestInterrupt(VBlank)
+    ram(cpu)[0x0100] = instructions.EI
+    ram(cpu)[0x0101] = instructions.LDB_B
+
+    // When
+    cpu.Execute(1)
+
+    if cpu.IME {
+        t.Error("IME should not be set right after EI.")
+    }
+
+    cpu.Execute(1)
+
+    if !cpu.IME {
+        t.Error("IME should be set after the instruction following EI.")
+    }
+
+    if cpu.Registers.PC != 0x0102 {
+        t.Error("The instruction following EI should be executed, PC: ", cpu.Registers.PC)
+    }
+
+    cpu.Execute(5)
+
+    if cpu.Registers.PC != 0x0040 {
+        t.Error("PC should be 0x0040, instead got: ", cpu.Registers.PC)
+    }
+
+    // Return address is the instruction after EI + LD B, B.
+    if ram(cpu)[0xFFFC] != 0x02 || ram(cpu)[0xFFFD] != 0x01 {
+        t.Error("Return address 0x0102 should be pushed to the stack.")
+    }
+}
+
+// TestDICancelsEI verifies that DI right after EI keeps interrupts disabled.
+func TestDICancelsEI(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.EI
+    ram(cpu)[0x0101] = instructions.DI
+
+    // When
+    expectedCycles := 2
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.IME {
+        t.Error("IME should be cleared after EI, DI.")
+    }
+}
+
+func TestDI(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = true
+    ram(cpu)[0x0100] = instructions.DI
+
+    // When
+    expectedCycles := 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.IME {
+        t.Error("IME should be cleared after DI.")
+    }
+}
+
+// TestRETIEnablesInterrupts verifies that RETI sets IME without delay.
+func TestRETIEnablesInterrupts(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.SP = 0xFFFC
+    ram(cpu)[0x0100] = instructions.RETI
+    ram(cpu)[0xFFFC] = 0x00
+    ram(cpu)[0xFFFD] = 0x20
+
+    // When
+    cpu.Execute(4)
+
+    if !cpu.IME {
+        t.Error("IME should be set right after RETI.")
+    }
+}
+
+// TestInterruptDispatchCancelledByIEOverwrite verifies that when pushing the MSB of PC
+// clears the pending bit in IE, the dispatch jumps to 0x0000.
+func TestInterruptDispatchCancelledByIEOverwrite(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = true
+    cpu.Registers.SP = 0x0000
+    cpu.Registers.PC = 0x0200 // MSB 0x02 is written to IE, disabling VBlank.
+    ram(cpu)[IEAddress] = 1 << VBlank
+    cpu.RequestInterrupt(VBlank)
+
+    // When
+    expectedCycles := 5
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.PC != 0x0000 {
+        t.Error("PC should be 0x0000, instead got: ", cpu.Registers.PC)
+    }
+
+    if ram(cpu)[IFAddress] & (1 << VBlank) == 0 {
+        t.Error("A cancelled dispatch should not acknowledge the request.")
+    }
+
+    if cpu.IME {
+        t.Error("IME should be cleared even if the dispatch is cancelled.")
+    }
+}
+
+// TestInterruptDispatchRedirectedByIEOverwrite verifies that the interrupt is chosen
+// after pushing the MSB of PC, so a lower priority one can be dispatched instead.
+func TestInterruptDispatchRedirectedByIEOverwrite(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = true
+    cpu.Registers.SP = 0x0000
+    cpu.Registers.PC = 0x0400 // MSB 0x04 is written to IE, enabling Timer only.
+    ram(cpu)[IEAddress] = 1 << VBlank
+    cpu.RequestInterrupt(VBlank)
+    cpu.RequestInterrupt(Timer)
+
+    // When
+    cpu.Execute(5)
+
+    if cpu.Registers.PC != 0x0050 {
+        t.Error("PC should be 0x0050, instead got: ", cpu.Registers.PC)
+    }
+
+    if ram(cpu)[IFAddress] != 1 << VBlank {
+        t.Error("Only the Timer request should be acknowledged, IF: ", ram(cpu)[IFAddress])
+    }
+}
@@ -1,14 +1,65 @@
 package arc
 
+import "cgbemu/src/alu"
+
 // PopFromSP returns the contents from the memory stack and increments SP by 1.
 // It consumes 1 machine cycle.
 // WARNING: SP might go into safe area (>0xFFFE) && (< 0xC000), might need a check later.
 func (cpu *CPU) PopFromSP(cycles *int) byte {
 
-    data := cpu.Memory.RAM[cpu.Registers.SP]
+    data := cpu.ReadByteFromMemory(cycles, cpu.Registers.SP)
     cpu.Registers.SP++
-    *cycles--
 
     return data
 }
 
+
+// PushToSP decrements SP by 1 and writes data into the memory stack.
+// It consumes 1 machine cycle.
+func (cpu *CPU) PushToSP(cycles *int, data byte) {
+
+    cpu.Registers.SP--
+    cpu.WriteByteToMemory(cycles, cpu.Registers.SP, data)
+}
+
+// Push pushes a register pair to the stack, MSB first.
+// It consumes 3 machine cycles: SP-1, W(msb), W(lsb).
+func (cpu *CPU) Push(cycles *int, msb, lsb byte) {
+
+    // A cycle is consumed just for decrementing SP.
+    cpu.tick(cycles)
+    cpu.PushToSP(cycles, msb)
+    cpu.PushToSP(cycles, lsb)
+}
+
+// LoadAddressSP executes LD (a16), SP: SP is stored at the 16-bit operand, LSB first.
+// Cycles: 5 machine cycles. opcode, R(lsb), R(msb), W(lsb), W(msb).
+func (cpu *CPU) LoadAddressSP(cycles *int) {
+
+    nn := cpu.FetchWord(cycles)
+
+    cpu.WriteByteToMemory(cycles, nn, byte(cpu.Registers.SP & 0xFF))
+    cpu.WriteByteToMemory(cycles, nn + 1, byte(cpu.Registers.SP >> 8))
+}
+
+// LoadHLSPOffset executes LD HL, SP+e: HL is loaded with SP plus the signed 8-bit operand e.
+// Sets following flags: Z = 0, N = 0, H = star, C = star
+// Cycles: 3 machine cycles. opcode, R(e), internal.
+func (cpu *CPU) LoadHLSPOffset(cycles *int) {
+
+    e := int8(cpu.FetchByte(cycles))
+    result, flags := alu.AddOffset(cpu.Registers.SP, e)
+    cpu.Registers.F = flags
+
+    cpu.Registers.L = byte(result & 0xFF)
+    cpu.Registers.H = byte(result >> 8)
+    cpu.tick(cycles)
+}
+
+// LoadSPHL executes LD SP, HL.
+// Cycles: 2 machine cycles. opcode, internal.
+func (cpu *CPU) LoadSPHL(cycles *int) {
+
+    cpu.Registers.SP = cpu.HL()
+    cpu.tick(cycles)
+}
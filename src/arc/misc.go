@@ -0,0 +1,61 @@
+package arc
+
+import "cgbemu/src/alu"
+
+// Halt executes HALT: enters low-power mode until an interrupt is pending.
+// Cycles: 1 machine cycle, then 1 per machine cycle spent halted.
+func (cpu *CPU) Halt() {
+
+    if !cpu.IME && cpu.PendingInterrupts() != 0 {
+
+        // With an interrupt already pending and IME = 0, HALT exits immediately,
+        // but the next opcode byte is fetched twice.
+        cpu.haltBug = true
+    }else {
+        cpu.Halted = true
+    }
+}
+
+// Stop executes STOP: enters very low-power mode, or switches CPU speed on CGB.
+// Cycles: 1 machine cycle, then 1 per machine cycle spent stopped.
+func (cpu *CPU) Stop() {
+
+    // STOP is followed by a byte which is skipped without being read.
+    cpu.Registers.PC++
+
+    // On CGB, an armed speed switch is performed instead of stopping.
+    switcher, ok := cpu.Bus.(SpeedSwitcher)
+    if !ok || !switcher.SwitchSpeed() {
+        cpu.Stopped = true
+        return
+    }
+    cpu.speedSwitched = true
+}
+
+// LockUp executes an illegal opcode. Illegal opcodes hard-lock the CPU: it stops fetching
+// instructions and interrupts can't wake it up, only a reset does.
+// Cycles: 1 machine cycle, then every following cycle is spent locked up.
+func (cpu *CPU) LockUp() {
+    cpu.lockedUp = true
+    cpu.RaiseFault(IllegalOpcode, 0)
+}
+
+// RLCA rotates A left, bit 7 goes into bit 0 and into the C flag.
+func (cpu *CPU) RLCA() {
+    cpu.Registers.A, cpu.Registers.F = alu.RLCA(cpu.Registers.A)
+}
+
+// RRCA rotates A right, bit 0 goes into bit 7 and into the C flag.
+func (cpu *CPU) RRCA() {
+    cpu.Registers.A, cpu.Registers.F = alu.RRCA(cpu.Registers.A)
+}
+
+// RLA rotates A left through the C flag.
+func (cpu *CPU) RLA() {
+    cpu.Registers.A, cpu.Registers.F = alu.RLA(cpu.Registers.A, cpu.Registers.F)
+}
+
+// RRA rotates A right through the C flag.
+func (cpu *CPU) RRA() {
+    cpu.Registers.A, cpu.Registers.F = alu.RRA(cpu.Registers.A, cpu.Registers.F)
+}
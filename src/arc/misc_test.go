@@ -0,0 +1,150 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"testing"
+)
+
+func TestNOP(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    want := cpu.Registers
+    want.PC = 0x0101
+    ram(cpu)[0x0100] = instructions.NOP
+
+    // When
+    expectedCycles := 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers != want {
+        t.Error("NOP should only increment PC, registers: ", cpu.Registers)
+    }
+}
+
+// TestRLCA verifies that RLCA always clears Z, unlike RLC A.
+func TestRLCA(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.A = 0x80
+    cpu.Registers.F = 0xE0
+    ram(cpu)[0x0100] = instructions.RLCA
+
+    // When
+    expectedCycles := 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.A != 0x01 {
+        t.Error("A register should be 0x01, instead got: ", cpu.Registers.A)
+    }
+
+    // Z = 0, N = 0, H = 0, C = 1
+    if cpu.Registers.F != 0x10 {
+        t.Error("F register should be 0x10, instead got: ", cpu.Registers.F)
+    }
+}
+
+func TestRRCA(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.A = 0x02
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.RRCA
+
+    // When
+    expectedCycles := 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.A != 0x01 {
+        t.Error("A register should be 0x01, instead got: ", cpu.Registers.A)
+    }
+
+    if cpu.Registers.F != 0x00 {
+        t.Error("F register should be 0x00, instead got: ", cpu.Registers.F)
+    }
+}
+
+// TestRLA verifies that the old carry goes into bit 0, and Z stays cleared on a zero result.
+func TestRLA(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.A = 0x80
+    cpu.Registers.F = 0x00
+    ram(cpu)[0x0100] = instructions.RLA
+
+    // When
+    expectedCycles := 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.A != 0x00 {
+        t.Error("A register should be 0x00, instead got: ", cpu.Registers.A)
+    }
+
+    if cpu.Registers.F != 0x10 {
+        t.Error("F register should be 0x10, instead got: ", cpu.Registers.F)
+    }
+}
+
+func TestRRA(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.Registers.A = 0x01
+    cpu.Registers.F = 0x10
+    ram(cpu)[0x0100] = instructions.RRA
+
+    // When
+    expectedCycles := 1
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != expectedCycles {
+        t.Error("Cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    if cpu.Registers.A != 0x80 {
+        t.Error("A register should be 0x80, instead got: ", cpu.Registers.A)
+    }
+
+    if cpu.Registers.F != 0x10 {
+        t.Error("F register should be 0x10, instead got: ", cpu.Registers.F)
+    }
+}
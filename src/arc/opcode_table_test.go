@@ -0,0 +1,100 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"errors"
+	"testing"
+)
+
+// conditionFlags returns the F register values making the condition true and false.
+func conditionFlags(condition string) (taken, notTaken byte) {
+    switch condition {
+    case "NZ":
+        return 0x00, 0x80
+    case "Z":
+        return 0x80, 0x00
+    case "NC":
+        return 0x00, 0x10
+    default:
+        return 0x10, 0x00
+    }
+}
+
+// runOpcode executes op at 0x0100, followed by zeroed operands, with the given flags,
+// and checks the cycles used against expectedCycles.
+// It returns the CPU after the execution and the error returned by Execute.
+func runOpcode(t *testing.T, op int, flags byte, expectedCycles int) (*CPU, error) {
+
+    cpu := InitSM83()
+    cpu.Registers.F = flags
+    ram(cpu)[0x0100] = byte(op)
+
+    cyclesUsed, err := cpu.Execute(expectedCycles)
+
+    if cyclesUsed != expectedCycles {
+        t.Error(instructions.Unprefixed[op], " (", op, "): cycles used: ", cyclesUsed, " cycles expected: ", expectedCycles)
+    }
+
+    return cpu, err
+}
+
+// TestUnprefixedOpcodeTable walks all 256 unprefixed opcodes and verifies that
+// each one has a defined behaviour, with the duration and length from the opcode table.
+func TestUnprefixedOpcodeTable(t *testing.T) {
+
+    for op, opcode := range instructions.Unprefixed {
+
+        // Illegal opcodes lock up the CPU.
+        if opcode.Illegal {
+            _, err := runOpcode(t, op, 0x00, 4)
+
+            var fault *Fault
+            if !errors.As(err, &fault) || fault.Kind != IllegalOpcode {
+                t.Error(opcode, " (", op, "): should lock up the CPU, instead got: ", err)
+            }
+            continue
+        }
+
+        // The prefix is executed together with the CB opcode, 0x00 is RLC B.
+        expectedCycles := opcode.Cycles
+        length := opcode.Length
+        if op == instructions.PREFIX_CB {
+            expectedCycles = instructions.CBPrefixed[0x00].Cycles
+            length = instructions.CBPrefixed[0x00].Length
+        }
+
+        if opcode.IsConditional() {
+            taken, notTaken := conditionFlags(opcode.Operands[0].Name)
+
+            cpu, err := runOpcode(t, op, taken, opcode.CyclesTaken)
+            if err != nil {
+                t.Error(opcode, " (", op, "): ", err)
+            }
+
+            cpu, err = runOpcode(t, op, notTaken, opcode.Cycles)
+            if err != nil {
+                t.Error(opcode, " (", op, "): ", err)
+            }
+
+            if cpu.Registers.PC != 0x0100 + uint16(length) {
+                t.Error(opcode, " (", op, "): not taken should leave PC at the next instruction, PC: ", cpu.Registers.PC)
+            }
+            continue
+        }
+
+        cpu, err := runOpcode(t, op, 0x00, expectedCycles)
+        if err != nil {
+            t.Error(opcode, " (", op, "): ", err)
+        }
+
+        // Jumps, calls, returns and restarts move PC elsewhere.
+        switch opcode.Mnemonic {
+        case "JP", "JR", "CALL", "RET", "RETI", "RST":
+            continue
+        }
+
+        if cpu.Registers.PC != 0x0100 + uint16(length) {
+            t.Error(opcode, " (", op, "): PC should be ", 0x0100 + length, ", instead got: ", cpu.Registers.PC)
+        }
+    }
+}
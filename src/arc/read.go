@@ -1,35 +1,25 @@
 package arc
 
-import "log"
-
 // ReadByteFromMemory returns the byte read at the address location in memory.
 // It consumes one clock cycle, without increasing the PC.
 func (cpu *CPU) ReadByteFromMemory(cycles *int, address uint16) byte{
 
-    if address > MaxMem - 1 {
-        log.Fatalf("PC exceeded max memory.")
-    }
-
-    byteRead := cpu.Memory.RAM[address]
-    *cycles--
+    // The read happens at the end of the machine cycle.
+    cpu.tick(cycles)
 
+    if !cpu.checkAccess(address) {
+        return 0xFF
+    }
 
-    return byteRead
+    return cpu.Bus.Read(address)
 }
 
 // ReadByteFromMemory returns the word read at the address location in memory.
-// It consumes one clock cycle, without increasing the PC.
+// It consumes two clock cycles, without increasing the PC.
 func (cpu *CPU) ReadWordFromMemory(cycles *int, address uint16) uint16{
 
-    if address > MaxMem - 1 {
-        log.Fatalf("Address %d exceeded max memory.", address)
-    }
-
-    lsb := cpu.Memory.RAM[address]
-    *cycles--
-
-    msb := cpu.Memory.RAM[address+1]
-    *cycles--
+    lsb := cpu.ReadByteFromMemory(cycles, address)
+    msb := cpu.ReadByteFromMemory(cycles, address+1)
 
     return uint16(msb) << 8 | uint16(lsb)
 }
@@ -0,0 +1,54 @@
+package arc
+
+// EventScheduler runs the events of the rest of the system: timer, PPU, APU, DMA.
+// scheduler.Scheduler implements it.
+type EventScheduler interface {
+
+    // MachineCyclesUntilNext returns the machine cycles that can run before the next event
+    // is due, at least 1, and -1 if no event is pending.
+    MachineCyclesUntilNext() int
+
+    // Tick advances the scheduler by the given machine cycles, running the events due.
+    Tick(cycles int)
+
+    // SetDoubleSpeed changes the length of the machine cycles counted by Tick.
+    SetDoubleSpeed(on bool)
+}
+
+// Run executes instructions for the given machine cycles, interleaved with the events of s.
+// Instead of polling s on every machine cycle, the CPU runs until the next event is due,
+// then s runs every event due. An event is run at the end of the instruction it falls due
+// in, seeing Now as its due cycle.
+//
+// s follows the CGB speed switches of the CPU, and must not be ticked by the bus as well.
+// Cycles used, cycle debt and faults are the ones of Execute.
+func (cpu *CPU) Run(s EventScheduler, cycles int) (cyclesUsed int, err error) {
+
+    s.SetDoubleSpeed(cpu.IsDoubleSpeed())
+
+    // The budget is counted as by Execute, the cycle debt included.
+    budget := cycles
+    for budget > 0 {
+
+        // Cycles owed by the previous slice are not executed again.
+        debt := cpu.cycleDebt
+        slice := budget
+        if next := s.MachineCyclesUntilNext(); next > 0 && next + debt < slice {
+            slice = next + debt
+        }
+
+        used, err := cpu.Execute(slice)
+        s.Tick(used)
+        cyclesUsed += used
+        budget -= used + debt - cpu.cycleDebt
+
+        if err != nil {
+            return cyclesUsed, err
+        }
+
+        // Execute returns after a speed switch, the following cycles have the new length.
+        s.SetDoubleSpeed(cpu.IsDoubleSpeed())
+    }
+
+    return cyclesUsed, nil
+}
@@ -0,0 +1,143 @@
+package arc
+
+import (
+    "testing"
+
+    "cgbemu/src/instructions"
+    "cgbemu/src/scheduler"
+)
+
+// TestRunStopsAtNextEvent verifies that Run executes the CPU up to the next event, then runs it.
+func TestRunStopsAtNextEvent(t *testing.T) {
+
+    cpu := InitSM83()
+    sched := scheduler.New()
+
+    // Given: NOPs, and an event due after 10 of them.
+    var pc uint16
+    var now uint64
+    runs := 0
+    e := scheduler.NewEvent("probe", func(at uint64) {
+        pc, now = cpu.Registers.PC, sched.Now()
+        runs++
+    })
+    sched.Schedule(e, 10 * scheduler.NormalSpeedRatio)
+
+    // When
+    cyclesUsed, err := cpu.Run(sched, 20)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if runs != 1 || pc != 0x010A {
+        t.Errorf("Event should run once after 10 NOPs, runs: %d, PC: 0x%04X", runs, pc)
+    }
+    if now != 10 * scheduler.NormalSpeedRatio {
+        t.Error("Event should see its due cycle, got ", now)
+    }
+    if cyclesUsed != 20 || sched.Now() != 20 * scheduler.NormalSpeedRatio {
+        t.Error("Cycles used: ", cyclesUsed, " scheduler at: ", sched.Now())
+    }
+}
+
+// TestRunRunsEventAfterInstruction verifies that an event due in the middle of an instruction
+// runs at its end, and that periodic events keep their period.
+func TestRunRunsEventAfterInstruction(t *testing.T) {
+
+    cpu := InitSM83()
+    sched := scheduler.New()
+
+    // Given: JP a16 takes 4 machine cycles, the event is due every 3.
+    for address := 0x0100; address < 0x0140; address += 3 {
+        ram(cpu)[address] = instructions.JP_a16
+        ram(cpu)[address + 1] = byte(address + 3)
+        ram(cpu)[address + 2] = 0x01
+    }
+    var pcs []uint16
+    var due []uint64
+    var e *scheduler.Event
+    e = scheduler.NewEvent("periodic", func(at uint64) {
+        pcs = append(pcs, cpu.Registers.PC)
+        due = append(due, at)
+        sched.ScheduleAt(e, at + 3 * scheduler.NormalSpeedRatio)
+    })
+    sched.Schedule(e, 3 * scheduler.NormalSpeedRatio)
+
+    // When
+    if _, err := cpu.Run(sched, 12); err != nil {
+        t.Fatal(err)
+    }
+
+    // Then: due on cycles 3, 6, 9, 12, run after the jumps ending on 4, 8, 12 and 12.
+    expectedPCs := []uint16{0x0103, 0x0106, 0x0109, 0x0109}
+    if len(pcs) != len(expectedPCs) {
+        t.Fatal("Event should run 4 times, got ", len(pcs))
+    }
+    for i := range expectedPCs {
+        if pcs[i] != expectedPCs[i] || due[i] != uint64(3 * (i + 1) * scheduler.NormalSpeedRatio) {
+            t.Errorf("Run %d: PC 0x%04X, due %d", i, pcs[i], due[i])
+        }
+    }
+}
+
+// TestRunKeepsCycleDebt verifies that the cycles overshot by Run are owed to the next call.
+func TestRunKeepsCycleDebt(t *testing.T) {
+
+    cpu := InitSM83()
+    sched := scheduler.New()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.JP_a16
+    ram(cpu)[0x0101] = 0x00
+    ram(cpu)[0x0102] = 0x02
+
+    // When
+    first, err := cpu.Run(sched, 2)
+    if err != nil {
+        t.Fatal(err)
+    }
+    second, err := cpu.Run(sched, 2)
+    if err != nil {
+        t.Fatal(err)
+    }
+    third, err := cpu.Run(sched, 2)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if first != 4 || second != 0 || third != 2 {
+        t.Error("Cycles used should be 4, 0 and 2, got ", first, ", ", second, " and ", third)
+    }
+    if sched.Now() != 6 * scheduler.NormalSpeedRatio {
+        t.Error("Scheduler should be at cycle ", 6 * scheduler.NormalSpeedRatio, " got ", sched.Now())
+    }
+}
+
+// TestRunFollowsSpeedSwitch verifies that the machine cycles following a speed switch are
+// counted at the new speed.
+func TestRunFollowsSpeedSwitch(t *testing.T) {
+
+    cpu := InitSM83()
+    sched := scheduler.New()
+
+    // Given
+    ram(cpu)[KEY1Address] = 0x01
+    ram(cpu)[0x0100] = instructions.STOP
+
+    // When
+    cyclesUsed, err := cpu.Run(sched, 10)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then: STOP at normal speed, then 9 NOPs at double speed.
+    if !sched.IsDoubleSpeed() {
+        t.Error("The scheduler should follow the switch to double speed")
+    }
+    expectedNow := uint64(scheduler.NormalSpeedRatio + 9 * scheduler.DoubleSpeedRatio)
+    if cyclesUsed != 10 || sched.Now() != expectedNow {
+        t.Error("Cycles used: ", cyclesUsed, " scheduler at: ", sched.Now(), " expected: ", expectedNow)
+    }
+}
@@ -0,0 +1,159 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"testing"
+)
+
+// TestStepExecutesOneInstruction verifies that Step returns the cycles and opcode of a single instruction.
+func TestStepExecutesOneInstruction(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.LDB_IM
+    ram(cpu)[0x0101] = 0x42
+    ram(cpu)[0x0102] = instructions.INC_A
+
+    // When
+    cyclesUsed, opcode, err := cpu.Step()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != 2 || opcode != instructions.LDB_IM {
+        t.Error("Step should execute LD B, n8 in 2 cycles, instead got: ", opcode, " in ", cyclesUsed)
+    }
+
+    if cpu.Registers.PC != 0x0102 {
+        t.Error("PC should be 0x0102, instead got: ", cpu.Registers.PC)
+    }
+
+    cyclesUsed, opcode, err = cpu.Step()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != 1 || opcode != instructions.INC_A {
+        t.Error("Step should execute INC A in 1 cycle, instead got: ", opcode, " in ", cyclesUsed)
+    }
+}
+
+// TestStepReturnsCBOpcode verifies that CB-prefixed instructions are reported as 0xCBxx.
+func TestStepReturnsCBOpcode(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.PREFIX_CB
+    ram(cpu)[0x0101] = instructions.SWAP_indHL
+
+    // When
+    cyclesUsed, opcode, err := cpu.Step()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != 4 || opcode != 0xCB00 | instructions.SWAP_indHL {
+        t.Error("Step should execute SWAP (HL) in 4 cycles, instead got: ", opcode, " in ", cyclesUsed)
+    }
+}
+
+// TestStepWhileHalted verifies that a halted CPU steps one machine cycle at a time.
+func TestStepWhileHalted(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.HALT
+    cpu.Step()
+
+    // When
+    cyclesUsed, opcode, err := cpu.Step()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != 1 || opcode != NoOpcode {
+        t.Error("Step should spend 1 cycle halted, instead got: ", opcode, " in ", cyclesUsed)
+    }
+}
+
+// TestStepDispatchesInterrupt verifies that an interrupt dispatch is a step of its own.
+func TestStepDispatchesInterrupt(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    cpu.IME = true
+    ram(cpu)[IEAddress] = 1 << Joypad
+    cpu.RequestInterrupt(Joypad)
+
+    // When
+    cyclesUsed, opcode, err := cpu.Step()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != 5 || opcode != NoOpcode {
+        t.Error("Step should dispatch the interrupt in 5 cycles, instead got: ", opcode, " in ", cyclesUsed)
+    }
+
+    if cpu.Registers.PC != 0x0060 {
+        t.Error("PC should be 0x0060, instead got: ", cpu.Registers.PC)
+    }
+}
+
+// TestExecuteCarriesCycleDebt verifies that cycles overshot by an Execute call are
+// subtracted from the next one.
+func TestExecuteCarriesCycleDebt(t *testing.T) {
+
+    cpu := InitSM83()
+
+    // Given
+    ram(cpu)[0x0100] = instructions.CALL_a16
+    ram(cpu)[0x0101] = 0x00
+    ram(cpu)[0x0102] = 0x20
+
+    // When
+    // CALL takes 6 cycles, 2 more than the budget.
+    cyclesUsed, err := cpu.Execute(4)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != 6 {
+        t.Error("Execute should overshoot to the end of CALL, cycles used: ", cyclesUsed)
+    }
+
+    // Only 2 NOPs fit in the next budget.
+    cyclesUsed, err = cpu.Execute(4)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != 2 {
+        t.Error("Execute should pay back the debt, cycles used: ", cyclesUsed)
+    }
+
+    if cpu.Registers.PC != 0x2002 {
+        t.Error("PC should be 0x2002, instead got: ", cpu.Registers.PC)
+    }
+
+    // A budget smaller than the debt executes nothing.
+    cpu.Registers.PC = 0x0100
+    cpu.Execute(1)
+    cyclesUsed, err = cpu.Execute(2)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if cyclesUsed != 0 || cpu.Registers.PC != 0x2000 {
+        t.Error("Execute should not run while in debt, cycles used: ", cyclesUsed)
+    }
+
+    cyclesUsed, _ = cpu.Execute(4)
+    if cyclesUsed != 1 {
+        t.Error("Remaining debt should be 3 cycles, cycles used: ", cyclesUsed)
+    }
+}
@@ -0,0 +1,242 @@
+package arc
+
+import (
+	"cgbemu/src/instructions"
+	"testing"
+)
+
+// access is a bus access seen by timingBus, on the machine cycle it happened.
+type access struct {
+    cycle   int
+    write   bool
+    address uint16
+}
+
+// timingBus is a flat memory that records every access with the machine cycle it
+// happened on, counted from 1, and calls onTick at the start of every machine cycle.
+type timingBus struct {
+    Memory
+    cycle       int
+    accesses    []access
+    onTick      func(cycle int)
+}
+
+func (b *timingBus) Read(address uint16) byte {
+    b.accesses = append(b.accesses, access{b.cycle, false, address})
+    return b.Memory.Read(address)
+}
+
+func (b *timingBus) Write(address uint16, value byte) {
+    b.accesses = append(b.accesses, access{b.cycle, true, address})
+    b.Memory.Write(address, value)
+}
+
+func (b *timingBus) Tick(cycles int) {
+    for i := 0; i < cycles; i++ {
+        b.cycle++
+        if b.onTick != nil {
+            b.onTick(b.cycle)
+        }
+    }
+}
+
+// initTimingSM83 returns a reset CPU connected to a timingBus.
+func initTimingSM83() (*CPU, *timingBus) {
+    bus := &timingBus{}
+    return NewCPU(bus), bus
+}
+
+// checkAccesses compares the accesses recorded by bus with want.
+func checkAccesses(t *testing.T, bus *timingBus, want []access) {
+    if len(bus.accesses) != len(want) {
+        t.Fatal("Accesses: ", bus.accesses, " expected: ", want)
+    }
+    for i := range want {
+        if bus.accesses[i] != want[i] {
+            t.Error("Access ", i, ": ", bus.accesses[i], " expected: ", want[i])
+        }
+    }
+}
+
+func TestLDa16_SPAccessTiming(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.LDa16_SP
+    bus.RAM[0x0101] = 0x00
+    bus.RAM[0x0102] = 0xC0
+
+    // When
+    cpu.Step()
+
+    // Then
+    checkAccesses(t, bus, []access{
+        {1, false, 0x0100},
+        {2, false, 0x0101},
+        {3, false, 0x0102},
+        {4, true, 0xC000},
+        {5, true, 0xC001},
+    })
+}
+
+func TestPUSHAccessTiming(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.PUSH_BC
+
+    // When
+    cpu.Step()
+
+    // Then, one internal cycle before the writes.
+    checkAccesses(t, bus, []access{
+        {1, false, 0x0100},
+        {3, true, 0xFFFD},
+        {4, true, 0xFFFC},
+    })
+}
+
+func TestCALLAccessTiming(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.CALL_a16
+    bus.RAM[0x0101] = 0x00
+    bus.RAM[0x0102] = 0x20
+
+    // When
+    cpu.Step()
+
+    // Then
+    checkAccesses(t, bus, []access{
+        {1, false, 0x0100},
+        {2, false, 0x0101},
+        {3, false, 0x0102},
+        {5, true, 0xFFFD},
+        {6, true, 0xFFFC},
+    })
+}
+
+func TestRETAccessTiming(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.RET
+
+    // When
+    cyclesUsed, _, _ := cpu.Step()
+
+    // Then, PC is loaded on the last cycle.
+    checkAccesses(t, bus, []access{
+        {1, false, 0x0100},
+        {2, false, 0xFFFE},
+        {3, false, 0xFFFF},
+    })
+    if cyclesUsed != 4 || bus.cycle != 4 {
+        t.Error("RET should take 4 cycles, used ", cyclesUsed, " ticked ", bus.cycle)
+    }
+}
+
+func TestINC_indHLAccessTiming(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.INC_indHL
+    cpu.Registers.H = 0xC0
+    cpu.Registers.L = 0x00
+
+    // When
+    cpu.Step()
+
+    // Then
+    checkAccesses(t, bus, []access{
+        {1, false, 0x0100},
+        {2, false, 0xC000},
+        {3, true, 0xC000},
+    })
+}
+
+func TestInterruptDispatchAccessTiming(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    cpu.IME = true
+    bus.RAM[IEAddress] = 1 << Timer
+    bus.RAM[IFAddress] = 1 << Timer
+
+    // When
+    cpu.Step()
+
+    // Then, only the pushes are timed: IF and IE are read between cycles.
+    var writes []access
+    for _, a := range bus.accesses {
+        if a.write && a.address != IFAddress {
+            writes = append(writes, a)
+        }
+    }
+    bus.accesses = writes
+    checkAccesses(t, bus, []access{
+        {3, true, 0xFFFD},
+        {4, true, 0xFFFC},
+    })
+    if bus.cycle != 5 {
+        t.Error("Dispatch should tick 5 cycles, ticked ", bus.cycle)
+    }
+}
+
+// TestLateReadSeesMidInstructionWrite verifies that a (HL) read on the third cycle of
+// BIT 0, (HL) sees a value written by the rest of the system during the second cycle.
+func TestLateReadSeesMidInstructionWrite(t *testing.T) {
+
+    // Given
+    cpu, bus := initTimingSM83()
+    bus.RAM[0x0100] = instructions.PREFIX_CB
+    bus.RAM[0x0101] = instructions.BIT0_indHL
+    cpu.Registers.H = 0xC0
+    cpu.Registers.L = 0x00
+    bus.RAM[0xC000] = 0x00
+    bus.onTick = func(cycle int) {
+        if cycle == 2 {
+            bus.RAM[0xC000] = 0x01
+        }
+    }
+
+    // When
+    cpu.Step()
+
+    // Then
+    if cpu.IsZflagSet() {
+        t.Error("BIT 0, (HL) should see the value written on cycle 2")
+    }
+}
+
+// TestEveryCycleIsTicked verifies that every instruction ticks the bus exactly once for
+// each machine cycle it uses.
+func TestEveryCycleIsTicked(t *testing.T) {
+
+    for op, opcode := range instructions.Unprefixed {
+        if opcode.Illegal || op == instructions.HALT || op == instructions.STOP {
+            continue
+        }
+
+        cpu, bus := initTimingSM83()
+        bus.RAM[0x0100] = byte(op)
+
+        cyclesUsed, _, _ := cpu.Step()
+        if cyclesUsed != bus.cycle {
+            t.Error(opcode, ": cycles used: ", cyclesUsed, " cycles ticked: ", bus.cycle)
+        }
+    }
+
+    for op, opcode := range instructions.CBPrefixed {
+
+        cpu, bus := initTimingSM83()
+        bus.RAM[0x0100] = instructions.PREFIX_CB
+        bus.RAM[0x0101] = byte(op)
+
+        cyclesUsed, _, _ := cpu.Step()
+        if cyclesUsed != bus.cycle {
+            t.Error(opcode, ": cycles used: ", cyclesUsed, " cycles ticked: ", bus.cycle)
+        }
+    }
+}
@@ -1,14 +1,20 @@
 package arc
 
-import "log"
-
 // WriteByteToMemory writes a byte into the absolute address location.
 // It consumes one clock cycle.
 func (cpu *CPU) WriteByteToMemory(cycles *int, address uint16, data byte) {
-    if address > MaxMem - 1 {
-        log.Fatalf("Address %d exceeded max memory.", address)
+
+    // The write happens at the end of the machine cycle.
+    cpu.tick(cycles)
+
+    if !cpu.checkAccess(address) {
+        return
     }
 
-    cpu.Memory.RAM[address] = data
-    *cycles--
+    cpu.Bus.Write(address, data)
+
+    // Writing into ROM either modifies code or switches banks, see blocks.go.
+    if address < blockCacheEnd {
+        cpu.InvalidateBlocks()
+    }
 }
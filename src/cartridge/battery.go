@@ -0,0 +1,76 @@
+package cartridge
+
+import (
+    "bytes"
+    "fmt"
+    "time"
+)
+
+// Battery is implemented by mappers whose RAM, and clock, survive power off.
+// The save data is the .sav file content: the RAM, followed by the clock state if any.
+type Battery interface {
+    SaveData() []byte
+    LoadSaveData(data []byte) error
+}
+
+// now returns the host time, stored in saves and used to sync clocks.
+var now = time.Now
+
+// HasBattery reports whether the cartridge keeps save data: battery-backed RAM, or RAM on flash.
+func (c *Cartridge) HasBattery() bool {
+    _, ok := c.Mapper.(Battery)
+    return ok && (c.Header.Type.Battery || c.hasFlashSRAM())
+}
+
+// SaveData returns the content of the .sav file, nil if the cartridge has no battery.
+func (c *Cartridge) SaveData() []byte {
+    if !c.HasBattery() {
+        return nil
+    }
+    return c.Mapper.(Battery).SaveData()
+}
+
+// LoadSaveData restores the content of a .sav file. If SyncClock is set, the clock of
+// the cartridge, if any, is then advanced by the host time elapsed since the save.
+func (c *Cartridge) LoadSaveData(data []byte) error {
+
+    if !c.HasBattery() {
+        return fmt.Errorf("cartridge: %s has no battery", c.Header.Type)
+    }
+
+    if err := c.Mapper.(Battery).LoadSaveData(data); err != nil {
+        return err
+    }
+
+    if clock, ok := c.Mapper.(clocked); ok && c.SyncClock {
+        clock.syncClock(now())
+    }
+    return nil
+}
+
+// clocked is implemented by mappers with a real time clock.
+type clocked interface {
+    syncClock(now time.Time)
+}
+
+// saveRAM returns a copy of ram, for mappers saving nothing else.
+func saveRAM(ram []byte) []byte {
+    return bytes.Clone(ram)
+}
+
+// loadRAM restores ram from data, which must be exactly its size.
+func loadRAM(ram []byte, data []byte) error {
+    if err := checkSaveSize(data, len(ram)); err != nil {
+        return err
+    }
+    copy(ram, data)
+    return nil
+}
+
+// checkSaveSize returns an error if data is not exactly size bytes.
+func checkSaveSize(data []byte, size int) error {
+    if len(data) != size {
+        return fmt.Errorf("cartridge: save data is %d bytes, expected %d", len(data), size)
+    }
+    return nil
+}
@@ -0,0 +1,152 @@
+package cartridge
+
+import (
+    "fmt"
+    "os"
+)
+
+// Mapper is the memory bank controller of a cartridge: it answers the CPU accesses to ROM
+// (0x0000-0x7FFF) and external RAM (0xA000-0xBFFF), and switches banks on writes to ROM.
+type Mapper interface {
+    Read(address uint16) byte
+    Write(address uint16, value byte)
+}
+
+// UnsupportedError is returned by New for cartridge types with no Mapper implementation.
+type UnsupportedError struct {
+    Type Type
+}
+
+func (e *UnsupportedError) Error() string {
+    if e.Type.MBC == UnknownMBC {
+        return fmt.Sprintf("cartridge: unknown cartridge type 0x%02X", e.Type.Code)
+    }
+    return fmt.Sprintf("cartridge: unsupported cartridge type 0x%02X (%s)", e.Type.Code, e.Type)
+}
+
+// Cartridge is a ROM with its header and the Mapper selected from the cartridge type.
+// It implements mmu.Cartridge, and mmu.PageMapper when the Mapper does.
+//
+// Cartridges with a clock must also be ticked: add them to the MMU with AddComponent.
+type Cartridge struct {
+    Header  Header
+    Mapper  Mapper
+
+    // Detection is set when the Mapper was not chosen from the cartridge type in the header,
+    // see New.
+    Detection *Detection
+
+    // SyncClock makes LoadSaveData advance the clock by the host time elapsed since the save
+    // was written. Otherwise the clock only advances with emulated time.
+    SyncClock bool
+}
+
+// Load reads the ROM file at path, see New.
+func Load(path string) (*Cartridge, error) {
+
+    rom, err := os.ReadFile(path)
+    if err != nil {
+        return nil, err
+    }
+    return New(rom)
+}
+
+// New parses the header of rom and selects its Mapper. It fails on invalid headers,
+// see ParseHeader, on ROMs smaller than their header says, and with an *UnsupportedError
+// on cartridge types with no Mapper.
+//
+// Multicarts and unlicensed cartridges whose header is missing or wrong are first recognized
+// from the ROM content, the guess is reported in Detection.
+// rom is used by the cartridge and must not be modified afterwards.
+func New(rom []byte) (*Cartridge, error) {
+
+    if c := detect(rom); c != nil {
+        return c, nil
+    }
+
+    h, err := ParseHeader(rom)
+    if err != nil {
+        return nil, err
+    }
+
+    if len(rom) < h.ROMSize {
+        return nil, fmt.Errorf("%w: %d bytes, %d in header", ErrTruncated, len(rom), h.ROMSize)
+    }
+
+    mapper, err := newMapper(h, rom[:h.ROMSize])
+    if err != nil {
+        return nil, err
+    }
+
+    return &Cartridge{Header: *h, Mapper: mapper}, nil
+}
+
+// newMapper returns the Mapper for the cartridge type in h.
+func newMapper(h *Header, rom []byte) (Mapper, error) {
+
+    switch h.Type.MBC {
+    case NoMBC:
+        return newROMOnly(h, rom), nil
+    case MBC1:
+        return newMBC1(h, rom), nil
+    case MBC2:
+        return newMBC2(h, rom), nil
+    case MBC3:
+        return newMBC3(h, rom), nil
+    case MBC5:
+        return newMBC5(h, rom), nil
+    case MMM01:
+        return newMMM01(h, rom), nil
+    case MBC7:
+        return newMBC7(h, rom), nil
+    case HuC1:
+        return newHuC1(h, rom), nil
+    case HuC3:
+        return newHuC3(h, rom), nil
+    }
+
+    return nil, &UnsupportedError{Type: h.Type}
+}
+
+// Read returns the byte at address in ROM or external RAM.
+func (c *Cartridge) Read(address uint16) byte {
+    return c.Mapper.Read(address)
+}
+
+// Write handles a write to ROM, usually to a mapper register, or to external RAM.
+func (c *Cartridge) Write(address uint16, value byte) {
+    c.Mapper.Write(address, value)
+}
+
+// pageMapper is mmu.PageMapper, implemented by mappers whose ROM can be read directly.
+type pageMapper interface {
+    Page(page byte) []byte
+}
+
+// Page returns the ROM page mapped at page, or nil if reads must go through Read.
+func (c *Cartridge) Page(page byte) []byte {
+    if m, ok := c.Mapper.(pageMapper); ok {
+        return m.Page(page)
+    }
+    return nil
+}
+
+// component is mmu.Component and mmu.SpeedAware, implemented by mappers running on their own.
+type component interface {
+    Tick(cycles int)
+    SetDoubleSpeed(on bool)
+}
+
+// Tick advances the hardware on the cartridge, such as a clock, by the machine cycles the CPU has used.
+func (c *Cartridge) Tick(cycles int) {
+    if m, ok := c.Mapper.(component); ok {
+        m.Tick(cycles)
+    }
+}
+
+// SetDoubleSpeed tells the hardware on the cartridge about CPU speed switches.
+func (c *Cartridge) SetDoubleSpeed(on bool) {
+    if m, ok := c.Mapper.(component); ok {
+        m.SetDoubleSpeed(on)
+    }
+}
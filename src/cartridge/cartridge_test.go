@@ -0,0 +1,264 @@
+package cartridge
+
+import (
+    "errors"
+    "testing"
+
+    "cgbemu/src/mmu"
+)
+
+var (
+    _ mmu.Cartridge     = (*Cartridge)(nil)
+    _ mmu.PageMapper    = (*Cartridge)(nil)
+)
+
+// buildROM returns a ROM of the size in the header, with a valid logo and checksums.
+// Every ROM bank starts with its bank number.
+func buildROM(t *testing.T, cartridgeType, romSizeCode, ramSizeCode byte) []byte {
+
+    size, err := romSize(romSizeCode)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    rom := make([]byte, size)
+    for bank := 0; bank < size / ROMBankSize; bank++ {
+        rom[bank * ROMBankSize] = byte(bank)
+    }
+
+    copy(rom[logoAddress:], Logo[:])
+    copy(rom[titleAddress:], "TEST")
+    rom[typeAddress] = cartridgeType
+    rom[romSizeAddress] = romSizeCode
+    rom[ramSizeAddress] = ramSizeCode
+    fixChecksums(rom)
+
+    return rom
+}
+
+// fixChecksums updates both checksums after the header is modified.
+func fixChecksums(rom []byte) {
+    rom[headerChecksumAddress] = HeaderChecksum(rom)
+    global := GlobalChecksum(rom)
+    rom[globalChecksumAddress] = byte(global >> 8)
+    rom[globalChecksumAddress + 1] = byte(global)
+}
+
+func TestParseHeaderOfCGBCartridge(t *testing.T) {
+
+    // Given
+    rom := buildROM(t, 0x1B, 0x05, 0x03)
+    copy(rom[titleAddress:], "POKEMON CRYAAXE")
+    rom[cgbFlagAddress] = 0xC0
+    copy(rom[newLicenseeAddress:], "01")
+    rom[sgbFlagAddress] = 0x03
+    rom[oldLicenseeAddress] = 0x33
+    rom[versionAddress] = 0x01
+    fixChecksums(rom)
+
+    // When
+    h, err := ParseHeader(rom)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    want := Header{
+        Title:          "POKEMON CRY",
+        Manufacturer:   "AAXE",
+        CGB:            CGBOnly,
+        SGB:            true,
+        Type:           Type{Code: 0x1B, MBC: MBC5, RAM: true, Battery: true},
+        ROMSize:        1024 * 1024,
+        RAMSize:        32 * 1024,
+        Licensee:       "01",
+        Version:        0x01,
+        HeaderChecksum: rom[headerChecksumAddress],
+        GlobalChecksum: uint16(rom[globalChecksumAddress]) << 8 | uint16(rom[globalChecksumAddress + 1]),
+        GlobalChecksumValid: true,
+    }
+    if *h != want {
+        t.Errorf("Header should be %+v, got %+v", want, *h)
+    }
+    if h.Type.String() != "MBC5+RAM+BATTERY" {
+        t.Error("Type should be MBC5+RAM+BATTERY, got ", h.Type)
+    }
+}
+
+func TestParseHeaderOfDMGCartridge(t *testing.T) {
+
+    // Given: the title takes the whole 16 bytes, the licensee is the old code.
+    rom := buildROM(t, 0x00, 0x00, 0x00)
+    copy(rom[titleAddress:], "SIXTEEN CHAR TTL")
+    rom[oldLicenseeAddress] = 0x01
+    fixChecksums(rom)
+
+    // When
+    h, err := ParseHeader(rom)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if h.Title != "SIXTEEN CHAR TTL" || h.Manufacturer != "" {
+        t.Error("Title should be SIXTEEN CHAR TTL with no manufacturer, got ", h.Title, ", ", h.Manufacturer)
+    }
+    if h.CGB != CGBUnsupported || h.SGB {
+        t.Error("Should be a DMG cartridge without SGB support, got ", h.CGB, ", SGB ", h.SGB)
+    }
+    if h.Licensee != "01" {
+        t.Error("Licensee should be 01, got ", h.Licensee)
+    }
+}
+
+func TestParseHeaderRejectsInvalidHeaders(t *testing.T) {
+
+    tests := []struct {
+        name    string
+        modify  func(rom []byte) []byte
+        want    error
+    }{
+        {"too small", func(rom []byte) []byte { return rom[:HeaderEnd - 1] }, ErrTooSmall},
+        {"logo", func(rom []byte) []byte { rom[logoAddress + 10] ^= 0xFF; return rom }, ErrLogo},
+        {"header checksum", func(rom []byte) []byte { rom[headerChecksumAddress]++; return rom }, ErrHeaderChecksum},
+        {"title", func(rom []byte) []byte { rom[titleAddress] = 'X'; return rom }, ErrHeaderChecksum},
+    }
+
+    for _, test := range tests {
+
+        // Given
+        rom := test.modify(buildROM(t, 0x00, 0x00, 0x00))
+
+        // When
+        _, err := ParseHeader(rom)
+
+        // Then
+        if !errors.Is(err, test.want) {
+            t.Error(test.name, ": error should be ", test.want, ", got ", err)
+        }
+    }
+}
+
+func TestParseHeaderRejectsUnknownSizes(t *testing.T) {
+
+    for _, address := range []int{romSizeAddress, ramSizeAddress} {
+
+        // Given
+        rom := buildROM(t, 0x00, 0x00, 0x00)
+        rom[address] = 0x42
+        fixChecksums(rom)
+
+        // When
+        _, err := ParseHeader(rom)
+
+        // Then
+        if err == nil {
+            t.Errorf("Size byte at 0x%04X: unknown size should be an error", address)
+        }
+    }
+}
+
+func TestGlobalChecksumMismatchIsNotAnError(t *testing.T) {
+
+    // Given
+    rom := buildROM(t, 0x00, 0x00, 0x00)
+    rom[0x4000] ^= 0xFF
+
+    // When
+    h, err := ParseHeader(rom)
+
+    // Then
+    if err != nil {
+        t.Fatal(err)
+    }
+    if h.GlobalChecksumValid {
+        t.Error("Global checksum should be reported invalid")
+    }
+}
+
+func TestNewRejectsTruncatedROM(t *testing.T) {
+
+    // Given: 64 KiB in the header.
+    rom := buildROM(t, 0x00, 0x01, 0x00)
+
+    // When
+    _, err := New(rom[:0x8000])
+
+    // Then
+    if !errors.Is(err, ErrTruncated) {
+        t.Error("Error should be ", ErrTruncated, ", got ", err)
+    }
+}
+
+func TestNewReportsUnsupportedTypes(t *testing.T) {
+
+    tests := []struct {
+        code    byte
+        message string
+    }{
+        {0x20, "cartridge: unsupported cartridge type 0x20 (MBC6)"},
+        {0x42, "cartridge: unknown cartridge type 0x42"},
+    }
+
+    for _, test := range tests {
+
+        // Given
+        rom := buildROM(t, test.code, 0x00, 0x00)
+
+        // When
+        _, err := New(rom)
+
+        // Then
+        var unsupported *UnsupportedError
+        if !errors.As(err, &unsupported) || unsupported.Type.Code != test.code {
+            t.Errorf("Type 0x%02X: error should be an *UnsupportedError, got %v", test.code, err)
+            continue
+        }
+        if err.Error() != test.message {
+            t.Errorf("Type 0x%02X: error should be %q, got %q", test.code, test.message, err)
+        }
+    }
+}
+
+func TestROMOnlyCartridge(t *testing.T) {
+
+    // Given
+    rom := buildROM(t, 0x09, 0x00, 0x02)
+
+    // When
+    c, err := New(rom)
+    if err != nil {
+        t.Fatal(err)
+    }
+    c.Write(0x2000, 0x01)
+    c.Write(0xA000, 0x42)
+    c.Write(0xBFFF, 0x24)
+
+    // Then: writes to ROM switch nothing, RAM is always enabled.
+    if c.Read(0x4000) != 0x01 {
+        t.Error("0x4000 should read bank 1, got ", c.Read(0x4000))
+    }
+    if c.Read(0xA000) != 0x42 || c.Read(0xBFFF) != 0x24 {
+        t.Error("RAM should read 0x42 and 0x24, got ", c.Read(0xA000), " and ", c.Read(0xBFFF))
+    }
+    if page := c.Page(0x41); page == nil || &page[0] != &rom[0x4100] {
+        t.Error("ROM pages should be read directly")
+    }
+}
+
+func TestROMOnlyCartridgeWithoutRAM(t *testing.T) {
+
+    // Given
+    c, err := New(buildROM(t, 0x00, 0x00, 0x00))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // When
+    c.Write(0xA000, 0x42)
+
+    // Then
+    if c.Read(0xA000) != 0xFF {
+        t.Error("Missing RAM should read 0xFF, got ", c.Read(0xA000))
+    }
+}
@@ -0,0 +1,157 @@
+package cartridge
+
+import (
+    "bytes"
+    "fmt"
+)
+
+// Detection tells why New chose the Mapper of a cartridge whose header can't be trusted:
+// multicarts and unlicensed cartridges, recognized from the ROM content.
+type Detection struct {
+    Mapper  string // The mapper chosen, e.g. "Sachen MMC1".
+    Reason  string // What in the ROM gave it away.
+}
+
+func (d *Detection) String() string {
+    return fmt.Sprintf("%s: %s", d.Mapper, d.Reason)
+}
+
+// detectors recognize cartridges from their ROM content, tried in order by New before the header.
+// They return nil when rom is not theirs.
+var detectors = []func(rom []byte) *Cartridge{
+    detectMMM01,
+    detectSachen,
+    detectWisdomTree,
+    detectBootlegMBC5,
+}
+
+// detect returns the cartridge recognized by the first detector, or nil.
+func detect(rom []byte) *Cartridge {
+    for _, detector := range detectors {
+        if c := detector(rom); c != nil {
+            return c
+        }
+    }
+    return nil
+}
+
+// detectMMM01 recognizes MMM01 multicarts from the header of their menu, in the last 32 KiB
+// of the ROM: the header at 0x0100 is the one of the first game.
+func detectMMM01(rom []byte) *Cartridge {
+
+    if len(rom) <= 2 * ROMBankSize || len(rom) % ROMBankSize != 0 {
+        return nil
+    }
+
+    menu := rom[len(rom) - 2 * ROMBankSize:]
+    h, err := ParseHeader(menu)
+    if err != nil || h.Type.MBC != MMM01 {
+        return nil
+    }
+    h.ROMSize = len(rom)
+    h.GlobalChecksumValid = GlobalChecksum(rom) == h.GlobalChecksum
+
+    return &Cartridge{
+        Header:     *h,
+        Mapper:     newMMM01(h, rom),
+        Detection:  &Detection{Mapper: "MMM01", Reason: "menu header in the last 32 KiB of ROM"},
+    }
+}
+
+// detectSachen recognizes Sachen cartridges: the Nintendo logo is not at 0x0104 but where
+// their scrambled addresses show it to the boot ROM, see sachen. CGB compatible ones are MMC2.
+func detectSachen(rom []byte) *Cartridge {
+
+    if len(rom) < 2 * ROMBankSize || len(rom) % ROMBankSize != 0 {
+        return nil
+    }
+
+    // The header as seen by the boot ROM, A7 being set only while it reads the logo.
+    view := make([]byte, HeaderEnd)
+    for address := uint16(0x0100); address < HeaderEnd; address++ {
+        scrambled := address
+        if address >= logoAddress && address < logoAddress + uint16(len(Logo)) {
+            scrambled |= 0x80
+        }
+        view[address] = rom[unscrambleSachen(scrambled)]
+    }
+
+    if bytes.Equal(rom[logoAddress : logoAddress + len(Logo)], Logo[:]) ||
+        !bytes.Equal(view[logoAddress : logoAddress + len(Logo)], Logo[:]) {
+        return nil
+    }
+
+    h := parseHeader(view)
+    h.ROMSize = len(rom)
+    h.RAMSize = 0
+    h.GlobalChecksumValid = GlobalChecksum(rom) == h.GlobalChecksum
+
+    name := "Sachen MMC1"
+    if h.CGB != CGBUnsupported {
+        name = "Sachen MMC2"
+    }
+
+    return &Cartridge{
+        Header:     *h,
+        Mapper:     newSachen(rom),
+        Detection:  &Detection{Mapper: name, Reason: "Nintendo logo at the scrambled address 0x0184"},
+    }
+}
+
+// detectWisdomTree recognizes Wisdom Tree cartridges: more than 32 KiB of ROM with a ROM only
+// header, and the publisher name in the first bank.
+func detectWisdomTree(rom []byte) *Cartridge {
+
+    h, err := ParseHeader(rom)
+    if err != nil || len(rom) <= wisdomTreeBankSize || len(rom) % wisdomTreeBankSize != 0 {
+        return nil
+    }
+    if rom[typeAddress] != 0x00 && rom[typeAddress] != 0xC0 {
+        return nil
+    }
+    if !bytes.Contains(rom[:ROMBankSize], []byte("WISDOM")) {
+        return nil
+    }
+    h.ROMSize = len(rom)
+    h.RAMSize = 0
+
+    return &Cartridge{
+        Header:     *h,
+        Mapper:     &wisdomTree{rom: rom},
+        Detection:  &Detection{Mapper: "Wisdom Tree", Reason: fmt.Sprintf("WISDOM in a %d KiB ROM with cartridge type 0x%02X", len(rom) >> 10, rom[typeAddress])},
+    }
+}
+
+// The most ROM MBC5 addresses, and MBC1 and MBC3.
+const (
+    mbc5MaxROMSize  = 0x200 * ROMBankSize
+    mbc1MaxROMSize  = 0x80 * ROMBankSize
+)
+
+// detectBootlegMBC5 recognizes the many bootlegs built on MBC5 clones, whose header is copied
+// from the original game: either an MBC1 or MBC3 header on a ROM too large for them, or an MBC5
+// header with a ROM size smaller than the ROM.
+func detectBootlegMBC5(rom []byte) *Cartridge {
+
+    h, err := ParseHeader(rom)
+    if err != nil || len(rom) > mbc5MaxROMSize || len(rom) % ROMBankSize != 0 {
+        return nil
+    }
+
+    var reason string
+    switch {
+    case h.Type.MBC == MBC5 && len(rom) > h.ROMSize:
+        reason = fmt.Sprintf("%d KiB ROM with %d KiB in the header", len(rom) >> 10, h.ROMSize >> 10)
+    case (h.Type.MBC == MBC1 || h.Type.MBC == MBC3) && len(rom) > mbc1MaxROMSize:
+        reason = fmt.Sprintf("%d KiB ROM with an %s header", len(rom) >> 10, h.Type.MBC)
+    default:
+        return nil
+    }
+
+    h.ROMSize = len(rom)
+    return &Cartridge{
+        Header:     *h,
+        Mapper:     newMBC5(h, rom),
+        Detection:  &Detection{Mapper: "bootleg MBC5", Reason: reason},
+    }
+}
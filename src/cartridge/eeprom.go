@@ -0,0 +1,212 @@
+package cartridge
+
+// eeprom is a 93LC56 serial EEPROM in 16-bit mode: 128 words, bit-banged by the game through
+// 4 lines, see the MBC7 EEPROM register. With CS high, DI is sampled on rising edges of CLK
+// and DO changes on them. Commands are a start bit, a 2-bit opcode and an 8-bit address, of
+// which the lower 7 bits select the word:
+//
+// 10 A: READ, DO outputs a dummy 0 then the word, and the next words for as long as CLK runs.
+// 01 A: WRITE the 16 bits that follow, if writes are enabled.
+// 11 A: ERASE, sets the word to 0xFFFF if writes are enabled.
+// 00 11xxxxxx: EWEN, enables writes. They are disabled at power on.
+// 00 00xxxxxx: EWDS, disables writes.
+// 00 10xxxxxx: ERAL, erases every word.
+// 00 01xxxxxx: WRAL, writes the 16 bits that follow to every word.
+//
+// Writes complete at once: DO reads 1, ready, as soon as CS goes low after them.
+type eeprom struct {
+    words   [eepromWords]uint16
+
+    cs, clk, di, do bool
+
+    writeEnabled    bool
+
+    // Bits received with CS high, and how many. Reset when CS goes low.
+    shift   uint32
+    bits    int
+
+    // Decoded command waiting for its data, or being read.
+    state   eepromState
+    address byte
+    output  uint16
+    left    int
+}
+
+const (
+    eepromWords     = 128
+    eepromSize      = eepromWords * 2
+
+    // Start bit, opcode, address.
+    eepromCommandBits = 11
+)
+
+type eepromState byte
+
+const (
+    eepromCommand   eepromState = iota // Receiving a command.
+    eepromRead                         // Shifting words out.
+    eepromWrite                        // Receiving the word of WRITE.
+    eepromWriteAll                     // Receiving the word of WRAL.
+    eepromDone                         // Ignoring CLK until CS goes low.
+)
+
+func newEEPROM() *eeprom {
+
+    e := &eeprom{do: true}
+    for i := range e.words {
+        e.words[i] = 0xFFFF
+    }
+    return e
+}
+
+// read returns the lines as in the MBC7 register: CS in bit 7, CLK in bit 6, DI in bit 1, DO in bit 0.
+func (e *eeprom) read() byte {
+
+    value := byte(0)
+    for _, line := range []struct {
+        on  bool
+        bit byte
+    }{
+        {e.cs, 0x80}, {e.clk, 0x40}, {e.di, 0x02}, {e.do, 0x01},
+    } {
+        if line.on {
+            value |= line.bit
+        }
+    }
+    return value
+}
+
+// write sets CS, CLK and DI from the MBC7 register, and clocks the EEPROM on rising edges of CLK.
+func (e *eeprom) write(value byte) {
+
+    cs := value & 0x80 != 0
+    clk := value & 0x40 != 0
+    e.di = value & 0x02 != 0
+
+    if !cs {
+        // Deselected: abort or finish the command, report ready.
+        e.shift, e.bits = 0, 0
+        e.state = eepromCommand
+        e.do = true
+    } else if clk && !e.clk && e.cs {
+        e.clock()
+    }
+
+    e.cs, e.clk = cs, clk
+}
+
+// clock handles a rising edge of CLK.
+func (e *eeprom) clock() {
+
+    switch e.state {
+
+    case eepromRead:
+        e.do = e.output & 0x8000 != 0
+        e.output <<= 1
+        e.left--
+        if e.left == 0 {
+            // Sequential read.
+            e.address = (e.address + 1) % eepromWords
+            e.output = e.words[e.address]
+            e.left = 16
+        }
+
+    case eepromCommand:
+        // Leading zeros before the start bit are ignored.
+        if e.bits == 0 && !e.di {
+            return
+        }
+        e.receive()
+        if e.bits == eepromCommandBits {
+            e.decode()
+        }
+
+    case eepromWrite, eepromWriteAll:
+        e.receive()
+        if e.bits < 16 {
+            return
+        }
+        if e.writeEnabled {
+            word := uint16(e.shift)
+            if e.state == eepromWrite {
+                e.words[e.address] = word
+            } else {
+                for i := range e.words {
+                    e.words[i] = word
+                }
+            }
+        }
+        e.state = eepromDone
+        e.do = false
+    }
+}
+
+func (e *eeprom) receive() {
+    e.shift <<= 1
+    if e.di {
+        e.shift |= 1
+    }
+    e.bits++
+}
+
+// decode starts the command in the 11 bits received.
+func (e *eeprom) decode() {
+
+    opcode := e.shift >> 8 & 0x03
+    field := byte(e.shift)
+    e.address = field % eepromWords
+    e.shift, e.bits = 0, 0
+    e.state = eepromDone
+
+    switch opcode {
+    case 0x02:
+        e.state = eepromRead
+        e.output = e.words[e.address]
+        e.left = 16
+        e.do = false // Dummy bit.
+
+    case 0x01:
+        e.state = eepromWrite
+
+    case 0x03:
+        if e.writeEnabled {
+            e.words[e.address] = 0xFFFF
+        }
+        e.do = false
+
+    default:
+        switch field >> 6 {
+        case 0x03:
+            e.writeEnabled = true
+        case 0x00:
+            e.writeEnabled = false
+        case 0x02:
+            if e.writeEnabled {
+                for i := range e.words {
+                    e.words[i] = 0xFFFF
+                }
+            }
+            e.do = false
+        case 0x01:
+            e.state = eepromWriteAll
+        }
+    }
+}
+
+// save returns the words, little-endian.
+func (e *eeprom) save() []byte {
+
+    data := make([]byte, eepromSize)
+    for i, word := range e.words {
+        data[i * 2] = byte(word)
+        data[i * 2 + 1] = byte(word >> 8)
+    }
+    return data
+}
+
+// load restores the words from save.
+func (e *eeprom) load(data []byte) {
+    for i := range e.words {
+        e.words[i] = uint16(data[i * 2]) | uint16(data[i * 2 + 1]) << 8
+    }
+}
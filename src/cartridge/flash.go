@@ -0,0 +1,367 @@
+package cartridge
+
+import (
+    "bytes"
+    "fmt"
+    "os"
+
+    "cgbemu/src/scheduler"
+)
+
+// flash is an AM29F016-class flash chip in byte mode, holding the ROM or SRAM of a flash cartridge.
+// It is programmed with JEDEC command sequences, unlock cycles decoding address bits 0-10:
+//
+// 0x555 <- 0xAA, 0x2AA <- 0x55, 0x555 <- 0xA0, address <- data: program a byte. Programming
+// only clears bits, bytes are erased to 0xFF.
+// 0x555 <- 0xAA, 0x2AA <- 0x55, 0x555 <- 0x80, 0x555 <- 0xAA, 0x2AA <- 0x55, then
+// 0x555 <- 0x10: erase the chip, or sector <- 0x30: erase the 64 KiB sector.
+// 0x555 <- 0xAA, 0x2AA <- 0x55, 0x555 <- 0x90: autoselect, reads return the chip IDs.
+// Any address <- 0xF0: reset, back to reading the array.
+//
+// Programming and erasing take as long as on the chip. Meanwhile every read returns the
+// status: bit 6 toggles on each read, bit 7 is the complement of bit 7 of the byte being
+// programmed, 0 when erasing, and bit 3 is set when erasing.
+type flash struct {
+    memory []byte
+
+    state       flashState
+    autoselect  bool
+
+    // T-cycles left until the chip is done programming or erasing, and T-cycles per machine cycle.
+    busy        int
+    ratio       int
+
+    // Status of the operation in progress.
+    status      byte
+
+    modified    bool
+}
+
+type flashState byte
+
+const (
+    flashReady          flashState = iota // Waiting for a command.
+    flashUnlocked                         // 0xAA written.
+    flashCommand                          // 0xAA, 0x55 written.
+    flashProgram                          // Waiting for the byte to program.
+    flashErase                            // 0x80 written.
+    flashEraseUnlocked                    // 0x80, 0xAA written.
+    flashEraseCommand                     // 0x80, 0xAA, 0x55 written.
+)
+
+const (
+    flashSectorSize = 0x10000
+
+    // Chip IDs returned in autoselect mode.
+    flashManufacturerID = 0x01 // AMD.
+    flashDeviceID       = 0xAD // AM29F016.
+
+    // Typical times from the datasheet, in T-cycles.
+    flashProgramTime        = 7 * cyclesPerSecond / 1000000
+    flashSectorEraseTime    = 1 * cyclesPerSecond
+    flashChipEraseTime      = 25 * cyclesPerSecond
+)
+
+func newFlash(memory []byte) *flash {
+    return &flash{memory: memory, ratio: scheduler.NormalSpeedRatio}
+}
+
+// idle reports whether reads return the ROM content.
+func (f *flash) idle() bool {
+    return f.busy == 0 && !f.autoselect
+}
+
+// read returns the byte at address in the chip, which is the status while busy.
+func (f *flash) read(address int) byte {
+
+    switch {
+    case f.busy > 0:
+        f.status ^= 0x40
+        return f.status
+    case f.autoselect:
+        switch address & 0xFF {
+        case 0x00:
+            return flashManufacturerID
+        case 0x01:
+            return flashDeviceID
+        case 0x02:
+            return 0x00 // Sector not protected.
+        }
+    }
+    return f.memory[address % len(f.memory)]
+}
+
+// write handles a write cycle at address in the chip.
+func (f *flash) write(address int, value byte) {
+
+    if f.busy > 0 {
+        return
+    }
+
+    unlock := address & 0x7FF
+    if value == 0xF0 && f.state != flashProgram {
+        f.state = flashReady
+        f.autoselect = false
+        return
+    }
+
+    next := flashReady
+    switch f.state {
+
+    case flashReady:
+        if unlock == 0x555 && value == 0xAA {
+            next = flashUnlocked
+        }
+
+    case flashUnlocked:
+        if unlock == 0x2AA && value == 0x55 {
+            next = flashCommand
+        }
+
+    case flashCommand:
+        if unlock != 0x555 {
+            break
+        }
+        switch value {
+        case 0xA0:
+            next = flashProgram
+        case 0x80:
+            next = flashErase
+        case 0x90:
+            f.autoselect = true
+        }
+
+    case flashProgram:
+        f.program(address, value)
+
+    case flashErase:
+        if unlock == 0x555 && value == 0xAA {
+            next = flashEraseUnlocked
+        }
+
+    case flashEraseUnlocked:
+        if unlock == 0x2AA && value == 0x55 {
+            next = flashEraseCommand
+        }
+
+    case flashEraseCommand:
+        switch {
+        case unlock == 0x555 && value == 0x10:
+            f.erase(0, len(f.memory), flashChipEraseTime)
+        case value == 0x30:
+            sector := address % len(f.memory) &^ (flashSectorSize - 1)
+            f.erase(sector, min(sector + flashSectorSize, len(f.memory)), flashSectorEraseTime)
+        }
+    }
+    f.state = next
+}
+
+// program clears the bits of the byte at address that are clear in value.
+func (f *flash) program(address int, value byte) {
+
+    address %= len(f.memory)
+    f.memory[address] &= value
+    f.modified = true
+
+    f.autoselect = false
+    f.busy = flashProgramTime
+    f.status = ^value & 0x80
+}
+
+// erase sets the bytes from start to end to 0xFF.
+func (f *flash) erase(start, end int, time int) {
+
+    for i := start; i < end; i++ {
+        f.memory[i] = 0xFF
+    }
+    f.modified = true
+
+    f.autoselect = false
+    f.busy = time
+    f.status = 0x08
+}
+
+// Tick advances the operation in progress by the machine cycles the CPU has used.
+func (f *flash) Tick(cycles int) {
+    f.busy = max(f.busy - cycles * f.ratio, 0)
+}
+
+// SetDoubleSpeed makes machine cycles last half the time: operations take real time.
+func (f *flash) SetDoubleSpeed(on bool) {
+    f.ratio = scheduler.NormalSpeedRatio
+    if on {
+        f.ratio = scheduler.DoubleSpeedRatio
+    }
+}
+
+// flashMBC5 is an MBC5 board with its ROM, its SRAM, or both, on flash chips. Every write to
+// 0x0000-0x7FFF goes to both the ROM chip and the MBC, the flash address being the ROM offset
+// the address reads. Reads and writes of 0xA000-0xBFFF go to the SRAM chip, at the RAM offset.
+type flashMBC5 struct {
+    *mbc5
+
+    // nil if the ROM, or the SRAM, is not on flash.
+    chip    *flash
+    sram    *flash
+}
+
+// flashAddress returns the offset in ROM that address reads.
+func (c *flashMBC5) flashAddress(address uint16) int {
+    if address < 0x4000 {
+        return int(address)
+    }
+    return c.romBank % (len(c.rom) / ROMBankSize) * ROMBankSize + int(address - 0x4000)
+}
+
+// isSRAM reports whether address is in the SRAM on flash.
+func (c *flashMBC5) isSRAM(address uint16) bool {
+    return c.sram != nil && address >= 0xA000 && address < 0xC000
+}
+
+func (c *flashMBC5) Read(address uint16) byte {
+
+    switch {
+    case address < 0x8000 && c.chip != nil:
+        return c.chip.read(c.flashAddress(address))
+    case c.isSRAM(address):
+        if offset := c.ramOffset(address); offset >= 0 {
+            return c.sram.read(offset)
+        }
+        return openBus
+    }
+    return c.mbc5.Read(address)
+}
+
+func (c *flashMBC5) Write(address uint16, value byte) {
+
+    switch {
+    case address < 0x8000 && c.chip != nil:
+        c.chip.write(c.flashAddress(address), value)
+    case c.isSRAM(address):
+        if offset := c.ramOffset(address); offset >= 0 {
+            c.sram.write(offset, value)
+        }
+        return
+    }
+    c.mbc5.Write(address, value)
+}
+
+// Page returns the ROM page in the banks currently mapped, nil while reads return the
+// chip status or IDs.
+func (c *flashMBC5) Page(page byte) []byte {
+    if c.chip != nil && !c.chip.idle() {
+        return nil
+    }
+    return c.mbc5.Page(page)
+}
+
+func (c *flashMBC5) Tick(cycles int) {
+    for _, chip := range c.chips() {
+        chip.Tick(cycles)
+    }
+}
+
+func (c *flashMBC5) SetDoubleSpeed(on bool) {
+    for _, chip := range c.chips() {
+        chip.SetDoubleSpeed(on)
+    }
+}
+
+// chips returns the flash chips on the board.
+func (c *flashMBC5) chips() []*flash {
+
+    var chips []*flash
+    for _, chip := range []*flash{c.chip, c.sram} {
+        if chip != nil {
+            chips = append(chips, chip)
+        }
+    }
+    return chips
+}
+
+// flashMapper returns the mapper of a cartridge getting a flash chip, an MBC5 board.
+// The board is only wrapped once: without chips, it works as the plain MBC5.
+func (c *Cartridge) flashMapper() (*flashMBC5, error) {
+
+    switch m := c.Mapper.(type) {
+    case *flashMBC5:
+        return m, nil
+    case *mbc5:
+        f := &flashMBC5{mbc5: m}
+        c.Mapper = f
+        return f, nil
+    }
+    return nil, fmt.Errorf("cartridge: flash emulation needs an MBC5 board, not %s", c.Header.Type)
+}
+
+// EnableFlash puts the ROM of the cartridge on an emulated AM29F016-class flash chip, as on
+// the flash cartridges homebrew is developed on, see ROMImage. Only MBC5 boards are supported.
+//
+// Call it before the cartridge is loaded in the MMU, and add the cartridge with AddComponent
+// for the chip operations to complete.
+func (c *Cartridge) EnableFlash() error {
+
+    m, err := c.flashMapper()
+    if err != nil {
+        return err
+    }
+
+    // The ROM given to New is not modified.
+    if m.chip == nil {
+        m.rom = bytes.Clone(m.rom)
+        m.chip = newFlash(m.rom)
+    }
+    return nil
+}
+
+// EnableFlashSRAM puts the external RAM of the cartridge on an emulated flash chip of the same
+// class as EnableFlash, 64 KiB sectors included: the game programs and erases it with the same
+// commands, at the RAM addresses. Only MBC5 boards with RAM are supported.
+//
+// Flash keeps its content without a battery: the cartridge then always has save data, see
+// HasBattery. Call it before the cartridge is loaded in the MMU, see EnableFlash.
+func (c *Cartridge) EnableFlashSRAM() error {
+
+    m, err := c.flashMapper()
+    if err != nil {
+        return err
+    }
+    if len(m.ram) == 0 {
+        return fmt.Errorf("cartridge: %s has no RAM to put on flash", c.Header.Type)
+    }
+
+    if m.sram == nil {
+        m.sram = newFlash(m.ram)
+    }
+    return nil
+}
+
+// hasFlashSRAM reports whether the external RAM is on flash, see EnableFlashSRAM.
+func (c *Cartridge) hasFlashSRAM() bool {
+    m, ok := c.Mapper.(*flashMBC5)
+    return ok && m.sram != nil
+}
+
+// ROMModified reports whether the game has programmed or erased its flash ROM.
+func (c *Cartridge) ROMModified() bool {
+    m, ok := c.Mapper.(*flashMBC5)
+    return ok && m.chip != nil && m.chip.modified
+}
+
+// ROMImage returns a copy of the ROM, as programmed by the game on flash cartridges.
+func (c *Cartridge) ROMImage() []byte {
+    if m, ok := c.Mapper.(*flashMBC5); ok && m.chip != nil {
+        return bytes.Clone(m.chip.memory)
+    }
+    return nil
+}
+
+// SaveROM writes the ROM image to path, to be loaded again with Load.
+func (c *Cartridge) SaveROM(path string) error {
+
+    rom := c.ROMImage()
+    if rom == nil {
+        return fmt.Errorf("cartridge: %s has no flash ROM", c.Header.Type)
+    }
+    return os.WriteFile(path, rom, 0644)
+}
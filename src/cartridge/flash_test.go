@@ -0,0 +1,267 @@
+package cartridge
+
+import (
+    "path/filepath"
+    "testing"
+)
+
+// newFlashCartridge returns a 2 MiB MBC5 cartridge with flash emulation, ROM bank 4 selected.
+func newFlashCartridge(t *testing.T) *Cartridge {
+
+    c := newTestCartridge(t, 0x19, 0x06, 0x00)
+    if err := c.EnableFlash(); err != nil {
+        t.Fatal(err)
+    }
+    c.Write(0x2000, 0x04)
+    return c
+}
+
+// sendFlashCommand writes the unlock cycles and a command.
+func sendFlashCommand(c *Cartridge, command byte) {
+    c.Write(0x0555, 0xAA)
+    c.Write(0x02AA, 0x55)
+    c.Write(0x0555, command)
+}
+
+// eraseSector erases the flash sector of address, and waits until done.
+func eraseSector(c *Cartridge, address uint16) {
+    sendFlashCommand(c, 0x80)
+    c.Write(0x0555, 0xAA)
+    c.Write(0x02AA, 0x55)
+    c.Write(address, 0x30)
+    c.Tick(flashSectorEraseTime / 4)
+}
+
+// newFlashSRAMCartridge returns an MBC5 cartridge with 32 KiB of RAM on flash, RAM bank 1
+// enabled and selected.
+func newFlashSRAMCartridge(t *testing.T) *Cartridge {
+
+    c := newTestCartridge(t, 0x1A, 0x06, 0x03)
+    if err := c.EnableFlashSRAM(); err != nil {
+        t.Fatal(err)
+    }
+    c.Write(0x0000, 0x0A)
+    c.Write(0x4000, 0x01)
+    return c
+}
+
+// sendFlashSRAMCommand writes the unlock cycles and a command to the SRAM chip.
+func sendFlashSRAMCommand(c *Cartridge, command byte) {
+    c.Write(0xA555, 0xAA)
+    c.Write(0xA2AA, 0x55)
+    c.Write(0xA555, command)
+}
+
+func TestFlashErasesSector(t *testing.T) {
+
+    // Given
+    c := newFlashCartridge(t)
+
+    // When: banks 4-7 are the sector at 0x10000.
+    sendFlashCommand(c, 0x80)
+    c.Write(0x0555, 0xAA)
+    c.Write(0x02AA, 0x55)
+    c.Write(0x4000, 0x30)
+
+    // Then: status, bit 6 toggling.
+    first, second := c.Read(0x4000), c.Read(0x4000)
+    if first & 0xBF != 0x08 || first ^ second != 0x40 {
+        t.Errorf("Status should be 0x08 with bit 6 toggling, got 0x%02X, 0x%02X", first, second)
+    }
+    if c.Page(0x40) != nil {
+        t.Error("Pages should be read through Read while busy")
+    }
+
+    // When
+    c.Tick(flashSectorEraseTime / 4)
+
+    // Then
+    if got := c.Read(0x4000); got != 0xFF {
+        t.Error("Bank 4 should be erased, got ", got)
+    }
+    c.Write(0x2000, 0x07)
+    if got := c.Read(0x4000); got != 0xFF {
+        t.Error("Bank 7 should be erased, got ", got)
+    }
+    c.Write(0x2000, 0x08)
+    if got := c.Read(0x4000); got != 0x08 {
+        t.Error("Bank 8 should not be erased, got ", got)
+    }
+}
+
+func TestFlashProgramsByte(t *testing.T) {
+
+    // Given
+    c := newFlashCartridge(t)
+    eraseSector(c, 0x4000)
+
+    // When
+    sendFlashCommand(c, 0xA0)
+    c.Write(0x4123, 0x42)
+
+    // Then: bit 7 is the complement of the data until done.
+    if got := c.Read(0x4123); got & 0x80 != 0x80 {
+        t.Errorf("Status bit 7 should be set, got 0x%02X", got)
+    }
+
+    // When
+    c.Tick(flashProgramTime)
+
+    // Then
+    if got := c.Read(0x4123); got != 0x42 {
+        t.Error("0x4123 should read 0x42, got ", got)
+    }
+
+    // When: programming only clears bits.
+    sendFlashCommand(c, 0xA0)
+    c.Write(0x4123, 0x0F)
+    c.Tick(flashProgramTime)
+
+    // Then
+    if got := c.Read(0x4123); got != 0x02 {
+        t.Error("0x4123 should read 0x02, got ", got)
+    }
+}
+
+func TestFlashIgnoresWrongSequences(t *testing.T) {
+
+    // Given
+    c := newFlashCartridge(t)
+    eraseSector(c, 0x4000)
+
+    // When: the second unlock cycle at the wrong address.
+    c.Write(0x0555, 0xAA)
+    c.Write(0x0555, 0x55)
+    c.Write(0x0555, 0xA0)
+    c.Write(0x4123, 0x42)
+
+    // Then
+    if got := c.Read(0x4123); got != 0xFF {
+        t.Error("0x4123 should still be erased, got ", got)
+    }
+}
+
+func TestFlashAutoselect(t *testing.T) {
+
+    // Given
+    c := newFlashCartridge(t)
+
+    // When
+    sendFlashCommand(c, 0x90)
+
+    // Then
+    if got := c.Read(0x0000); got != flashManufacturerID {
+        t.Error("0x0000 should read the manufacturer ID, got ", got)
+    }
+    if got := c.Read(0x0001); got != flashDeviceID {
+        t.Error("0x0001 should read the device ID, got ", got)
+    }
+
+    // When
+    c.Write(0x0000, 0xF0)
+
+    // Then
+    if got := c.Read(0x4000); got != 0x04 {
+        t.Error("Reset should return to reading bank 4, got ", got)
+    }
+}
+
+func TestFlashROMIsSaved(t *testing.T) {
+
+    // Given
+    c := newFlashCartridge(t)
+    eraseSector(c, 0x4000)
+    sendFlashCommand(c, 0xA0)
+    c.Write(0x4001, 0x5A)
+    c.Tick(flashProgramTime)
+
+    // When
+    path := filepath.Join(t.TempDir(), "flash.gbc")
+    if err := c.SaveROM(path); err != nil {
+        t.Fatal(err)
+    }
+    loaded, err := Load(path)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if !c.ROMModified() {
+        t.Error("ROM should be reported modified")
+    }
+    loaded.Write(0x2000, 0x04)
+    if got := loaded.Read(0x4001); got != 0x5A {
+        t.Error("Programmed byte should be saved, got ", got)
+    }
+}
+
+func TestFlashNeedsMBC5(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0x01, 0x06, 0x00)
+
+    // When
+    err := c.EnableFlash()
+
+    // Then
+    if err == nil {
+        t.Error("Flash emulation on MBC1 should be an error")
+    }
+}
+
+func TestFlashSRAMIsSaved(t *testing.T) {
+
+    // Given: the RAM fits in the first sector.
+    c := newFlashSRAMCartridge(t)
+    sendFlashSRAMCommand(c, 0x80)
+    c.Write(0xA555, 0xAA)
+    c.Write(0xA2AA, 0x55)
+    c.Write(0xA010, 0x30)
+    c.Tick(flashSectorEraseTime / 4)
+
+    // When
+    sendFlashSRAMCommand(c, 0xA0)
+    c.Write(0xA010, 0x12)
+    c.Tick(flashProgramTime)
+    c.Write(0xA011, 0x34)
+
+    // Then
+    if got := c.Read(0xA010); got != 0x12 {
+        t.Error("Programmed byte should be read back, got ", got)
+    }
+    if got := c.Read(0xA011); got != 0xFF {
+        t.Error("Plain writes to flash should be ignored, got ", got)
+    }
+    if !c.HasBattery() {
+        t.Error("Flash SRAM should keep save data")
+    }
+    data := c.SaveData()
+    if data[RAMBankSize + 0x10] != 0x12 {
+        t.Error("Save data should hold the programmed byte, got ", data[RAMBankSize + 0x10])
+    }
+
+    // When
+    loaded := newFlashSRAMCartridge(t)
+    if err := loaded.LoadSaveData(data); err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if got := loaded.Read(0xA010); got != 0x12 {
+        t.Error("Loaded save data should be on flash, got ", got)
+    }
+}
+
+func TestFlashSRAMNeedsRAM(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0x19, 0x06, 0x00)
+
+    // When
+    err := c.EnableFlashSRAM()
+
+    // Then
+    if err == nil {
+        t.Error("Flash SRAM on a cartridge without RAM should be an error")
+    }
+}
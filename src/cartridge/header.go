@@ -0,0 +1,238 @@
+package cartridge
+
+import (
+    "bytes"
+    "errors"
+    "fmt"
+    "strings"
+)
+
+// The cartridge header sits at 0x0100-0x014F of ROM bank 0, see:
+//
+// https://gbdev.io/pandocs/The_Cartridge_Header.html
+const (
+    logoAddress             = 0x0104
+    titleAddress            = 0x0134
+    manufacturerAddress     = 0x013F
+    cgbFlagAddress          = 0x0143
+    newLicenseeAddress      = 0x0144
+    sgbFlagAddress          = 0x0146
+    typeAddress             = 0x0147
+    romSizeAddress          = 0x0148
+    ramSizeAddress          = 0x0149
+    oldLicenseeAddress      = 0x014B
+    versionAddress          = 0x014C
+    headerChecksumAddress   = 0x014D
+    globalChecksumAddress   = 0x014E
+
+    // HeaderEnd is the size of ROM needed to hold the whole header.
+    HeaderEnd = 0x0150
+
+    // ROMBankSize and RAMBankSize are the sizes of the banks switched by the mappers.
+    ROMBankSize = 0x4000
+    RAMBankSize = 0x2000
+)
+
+// Logo is the Nintendo logo bitmap every cartridge carries at 0x0104-0x0133.
+// The boot ROM refuses to start a cartridge whose logo differs.
+var Logo = [48]byte{
+    0xCE, 0xED, 0x66, 0x66, 0xCC, 0x0D, 0x00, 0x0B, 0x03, 0x73, 0x00, 0x83, 0x00, 0x0C, 0x00, 0x0D,
+    0x00, 0x08, 0x11, 0x1F, 0x88, 0x89, 0x00, 0x0E, 0xDC, 0xCC, 0x6E, 0xE6, 0xDD, 0xDD, 0xD9, 0x99,
+    0xBB, 0xBB, 0x67, 0x63, 0x6E, 0x0E, 0xEC, 0xCC, 0xDD, 0xDC, 0x99, 0x9F, 0xBB, 0xB9, 0x33, 0x3E,
+}
+
+// Header errors returned by ParseHeader and New.
+var (
+    ErrTooSmall         = errors.New("cartridge: ROM too small to hold a header")
+    ErrLogo             = errors.New("cartridge: Nintendo logo mismatch")
+    ErrHeaderChecksum   = errors.New("cartridge: header checksum mismatch")
+    ErrTruncated        = errors.New("cartridge: ROM smaller than the size in its header")
+)
+
+// CGBSupport is the CGB flag at 0x0143.
+type CGBSupport byte
+
+const (
+    CGBUnsupported  CGBSupport = 0x00 // DMG cartridge, run in compatibility mode.
+    CGBCompatible   CGBSupport = 0x80 // Runs on DMG, with CGB enhancements.
+    CGBOnly         CGBSupport = 0xC0 // Runs on CGB only.
+)
+
+func (s CGBSupport) String() string {
+    switch s {
+    case CGBCompatible:
+        return "CGB compatible"
+    case CGBOnly:
+        return "CGB only"
+    default:
+        return "DMG"
+    }
+}
+
+// Header is the parsed cartridge header.
+type Header struct {
+    Title           string
+
+    // Manufacturer is the 4 characters code of CGB cartridges, empty on older ones.
+    Manufacturer    string
+
+    CGB             CGBSupport
+
+    // SGB is set if the cartridge supports Super Game Boy functions.
+    SGB             bool
+
+    Type            Type
+
+    // ROMSize and RAMSize are in bytes. RAMSize is 0 for mappers with built-in RAM, e.g. MBC2.
+    ROMSize         int
+    RAMSize         int
+
+    // Licensee is the publisher code: the 2 characters new licensee code, or the old
+    // one in hexadecimal on cartridges predating it.
+    Licensee        string
+
+    Version         byte
+
+    HeaderChecksum  byte
+    GlobalChecksum  uint16
+
+    // GlobalChecksumValid is set if GlobalChecksum matches the ROM. No hardware checks it,
+    // so a mismatch is not an error.
+    GlobalChecksumValid bool
+}
+
+// ParseHeader parses and validates the header of rom. It fails if the Nintendo logo or the
+// header checksum are wrong, as the boot ROM does, or if the ROM or RAM size is unknown.
+// Unknown cartridge types are not an error here, see New.
+func ParseHeader(rom []byte) (*Header, error) {
+
+    if len(rom) < HeaderEnd {
+        return nil, ErrTooSmall
+    }
+
+    if !bytes.Equal(rom[logoAddress : logoAddress + len(Logo)], Logo[:]) {
+        return nil, ErrLogo
+    }
+
+    h := parseHeader(rom)
+
+    if checksum := HeaderChecksum(rom); checksum != h.HeaderChecksum {
+        return nil, fmt.Errorf("%w: 0x%02X in header, 0x%02X computed", ErrHeaderChecksum, h.HeaderChecksum, checksum)
+    }
+
+    var err error
+    if h.ROMSize, err = romSize(rom[romSizeAddress]); err != nil {
+        return nil, err
+    }
+    if h.RAMSize, err = ramSize(rom[ramSizeAddress]); err != nil {
+        return nil, err
+    }
+
+    return h, nil
+}
+
+// parseHeader reads the header fields of rom, which must hold a header, without validating
+// them. ROMSize and RAMSize are left to the caller.
+func parseHeader(rom []byte) *Header {
+
+    h := &Header{
+        CGB:            cgbSupport(rom[cgbFlagAddress]),
+        SGB:            rom[sgbFlagAddress] == 0x03,
+        Type:           TypeOf(rom[typeAddress]),
+        Version:        rom[versionAddress],
+        HeaderChecksum: rom[headerChecksumAddress],
+        GlobalChecksum: uint16(rom[globalChecksumAddress]) << 8 | uint16(rom[globalChecksumAddress + 1]),
+    }
+    h.GlobalChecksumValid = GlobalChecksum(rom) == h.GlobalChecksum
+
+    // CGB cartridges use the end of the title for the manufacturer code and the CGB flag.
+    if h.CGB == CGBUnsupported {
+        h.Title = text(rom[titleAddress : cgbFlagAddress + 1])
+    } else {
+        h.Title = text(rom[titleAddress : manufacturerAddress])
+        h.Manufacturer = text(rom[manufacturerAddress : cgbFlagAddress])
+    }
+
+    if rom[oldLicenseeAddress] == 0x33 {
+        h.Licensee = text(rom[newLicenseeAddress : newLicenseeAddress + 2])
+    } else {
+        h.Licensee = fmt.Sprintf("%02X", rom[oldLicenseeAddress])
+    }
+
+    return h
+}
+
+// cgbSupport decodes the CGB flag: only bit 7 is checked by the CGB, older cartridges have
+// the last character of the title there.
+func cgbSupport(flag byte) CGBSupport {
+    if flag & 0x80 == 0 {
+        return CGBUnsupported
+    }
+    return CGBSupport(flag & 0xC0)
+}
+
+// HeaderChecksum returns the checksum of the header bytes 0x0134-0x014C, as computed by the boot ROM.
+func HeaderChecksum(rom []byte) byte {
+
+    checksum := byte(0)
+    for _, b := range rom[titleAddress : headerChecksumAddress] {
+        checksum = checksum - b - 1
+    }
+    return checksum
+}
+
+// GlobalChecksum returns the sum of all the ROM bytes, except the global checksum itself.
+func GlobalChecksum(rom []byte) uint16 {
+
+    sum := uint16(0)
+    for i, b := range rom {
+        if i != globalChecksumAddress && i != globalChecksumAddress + 1 {
+            sum += uint16(b)
+        }
+    }
+    return sum
+}
+
+// romSize decodes the ROM size byte: 32 KiB << n, plus the 1.1, 1.2 and 1.5 MiB sizes
+// listed in some documents, never seen in a released cartridge.
+func romSize(code byte) (int, error) {
+    switch {
+    case code <= 0x08:
+        return 0x8000 << code, nil
+    case code == 0x52:
+        return 72 * ROMBankSize, nil
+    case code == 0x53:
+        return 80 * ROMBankSize, nil
+    case code == 0x54:
+        return 96 * ROMBankSize, nil
+    }
+    return 0, fmt.Errorf("cartridge: unknown ROM size 0x%02X", code)
+}
+
+// ramSize decodes the RAM size byte.
+func ramSize(code byte) (int, error) {
+    switch code {
+    case 0x00:
+        return 0, nil
+    case 0x01:
+        // Listed in some documents, never seen in a released cartridge.
+        return 0x800, nil
+    case 0x02:
+        return RAMBankSize, nil
+    case 0x03:
+        return 4 * RAMBankSize, nil
+    case 0x04:
+        return 16 * RAMBankSize, nil
+    case 0x05:
+        return 8 * RAMBankSize, nil
+    }
+    return 0, fmt.Errorf("cartridge: unknown RAM size 0x%02X", code)
+}
+
+// text returns the ASCII string in b, up to the first NUL, without trailing spaces.
+func text(b []byte) string {
+    if i := bytes.IndexByte(b, 0); i >= 0 {
+        b = b[:i]
+    }
+    return strings.TrimRight(string(b), " ")
+}
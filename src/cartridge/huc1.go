@@ -0,0 +1,96 @@
+package cartridge
+
+// huc1 is Hudson's HuC1 controller, up to 1 MiB of ROM, 32 KiB of RAM and an IR port:
+//
+// 0x0000-0x1FFF: 0x0E maps the IR port at 0xA000-0xBFFF, anything else maps RAM.
+// 0x2000-0x3FFF: ROM bank at 0x4000, 6 bits. Bank 0 can be mapped.
+// 0x4000-0x5FFF: RAM bank, 2 bits.
+//
+// There is no RAM enable, RAM is always mapped outside of IR mode.
+type huc1 struct {
+    rom []byte
+    ram []byte
+
+    irMode  bool
+    romBank byte
+    ramBank byte
+
+    infrared
+}
+
+func newHuC1(h *Header, rom []byte) *huc1 {
+
+    c := &huc1{rom: rom, romBank: 1}
+    if h.Type.RAM {
+        c.ram = make([]byte, h.RAMSize)
+    }
+    return c
+}
+
+// ramOffset returns the offset in RAM of address, or -1 if RAM is missing.
+func (c *huc1) ramOffset(address uint16) int {
+
+    if len(c.ram) == 0 {
+        return -1
+    }
+    return (int(c.ramBank) * RAMBankSize + int(address - 0xA000)) % len(c.ram)
+}
+
+func (c *huc1) Read(address uint16) byte {
+
+    switch {
+    case address < 0x4000:
+        return c.rom[address]
+    case address < 0x8000:
+        return romBank(c.rom, int(c.romBank))[address - 0x4000]
+    case address >= 0xA000 && address < 0xC000:
+        if c.irMode {
+            return c.infrared.read()
+        }
+        if offset := c.ramOffset(address); offset >= 0 {
+            return c.ram[offset]
+        }
+    }
+    return openBus
+}
+
+func (c *huc1) Write(address uint16, value byte) {
+
+    switch {
+    case address < 0x2000:
+        c.irMode = value & 0x0F == 0x0E
+
+    case address < 0x4000:
+        c.romBank = value & 0x3F
+
+    case address < 0x6000:
+        c.ramBank = value & 0x03
+
+    case address >= 0xA000 && address < 0xC000:
+        if c.irMode {
+            c.infrared.write(value)
+        } else if offset := c.ramOffset(address); offset >= 0 {
+            c.ram[offset] = value
+        }
+    }
+}
+
+// Page returns the ROM page in the banks currently mapped.
+func (c *huc1) Page(page byte) []byte {
+
+    switch {
+    case page < 0x40:
+        return c.rom[int(page) << 8 :][:0x100]
+    case page < 0x80:
+        return romBank(c.rom, int(c.romBank))[int(page - 0x40) << 8 :][:0x100]
+    }
+    return nil
+}
+
+func (c *huc1) SaveData() []byte {
+    return saveRAM(c.ram)
+}
+
+func (c *huc1) LoadSaveData(data []byte) error {
+    return loadRAM(c.ram, data)
+}
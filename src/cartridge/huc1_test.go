@@ -0,0 +1,81 @@
+package cartridge
+
+import (
+    "testing"
+)
+
+func TestHuC1SwitchesBanks(t *testing.T) {
+
+    // Given: 1 MiB of ROM, 32 KiB of RAM.
+    c := newTestCartridge(t, 0xFF, 0x05, 0x03)
+
+    // When
+    c.Write(0x2000, 0x00)
+
+    // Then
+    if got := c.Read(0x4000); got != 0x00 {
+        t.Error("0x4000 should read bank 0, got ", got)
+    }
+
+    // When
+    c.Write(0x2000, 0xFF)
+
+    // Then: 6 bits.
+    if got := c.Read(0x4000); got != 0x3F {
+        t.Error("0x4000 should read bank 0x3F, got ", got)
+    }
+
+    // When: RAM needs no enable.
+    for bank := byte(0); bank < 4; bank++ {
+        c.Write(0x4000, bank)
+        c.Write(0xA000, 0x10 + bank)
+    }
+
+    // Then
+    for bank := byte(0); bank < 4; bank++ {
+        c.Write(0x4000, bank)
+        if got := c.Read(0xA000); got != 0x10 + bank {
+            t.Errorf("RAM bank %d should read 0x%02X, got 0x%02X", bank, 0x10 + bank, got)
+        }
+    }
+}
+
+func TestHuC1InfraredPort(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0xFF, 0x01, 0x02)
+    c.Write(0xA000, 0x42)
+
+    var events []bool
+    c.OnIR(func(on bool) { events = append(events, on) })
+
+    // When
+    c.Write(0x0000, 0x0E)
+    c.Write(0xA000, 0x01)
+    c.Write(0xA000, 0x01)
+    c.Write(0xB000, 0x00)
+
+    // Then
+    if len(events) != 2 || !events[0] || events[1] {
+        t.Error("IR events should be on, off, got ", events)
+    }
+    if got := c.Read(0xA000); got != 0xC0 {
+        t.Error("IR port should read 0xC0 in the dark, got ", got)
+    }
+
+    // When
+    c.SetIRLight(true)
+
+    // Then
+    if got := c.Read(0xA000); got != 0xC1 {
+        t.Error("IR port should read 0xC1 when seeing light, got ", got)
+    }
+
+    // When
+    c.Write(0x0000, 0x00)
+
+    // Then: RAM was not written in IR mode.
+    if got := c.Read(0xA000); got != 0x42 {
+        t.Error("RAM should be mapped again, got ", got)
+    }
+}
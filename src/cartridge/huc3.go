@@ -0,0 +1,307 @@
+package cartridge
+
+import (
+    "encoding/binary"
+    "fmt"
+    "time"
+
+    "cgbemu/src/scheduler"
+)
+
+// huc3 is Hudson's HuC3 controller, up to 2 MiB of ROM, 32 KiB of RAM, an IR port, and a
+// microcontroller keeping a clock and driving a speaker:
+//
+// 0x0000-0x1FFF: What 0xA000-0xBFFF maps, see the modes below.
+// 0x2000-0x3FFF: ROM bank at 0x4000, 7 bits. Bank 0 can be mapped.
+// 0x4000-0x5FFF: RAM bank.
+//
+// The game talks to the microcontroller by writing commands to 0xA000 in huc3Command mode,
+// a 3-bit command in bits 4-6 and a 4-bit argument, and reading results in huc3Result mode:
+// the last command in bits 4-6 and a 4-bit result. The microcontroller has 256 nibbles of
+// memory, the clock is at 0x00-0x06: minutes of the day then days, least significant first.
+//
+// 0x1: Read the nibble at the address, and increment the address.
+// 0x2: Write the argument at the address.
+// 0x3: Write the argument at the address, and increment the address.
+// 0x4: Set the lower nibble of the address.
+// 0x5: Set the upper nibble of the address.
+// 0x6: Extended command, such as playing a tone, not emulated.
+//
+// Commands complete at once, the semaphore always reads ready.
+type huc3 struct {
+    rom []byte
+    ram []byte
+
+    mode    byte
+    romBank byte
+    ramBank byte
+
+    infrared
+
+    clock   huc3Clock
+    memory  [0x100]byte
+    address byte
+    command byte
+    result  byte
+}
+
+// Modes selected at 0x0000-0x1FFF.
+const (
+    huc3RAMReadOnly = 0x0
+    huc3RAM         = 0xA
+    huc3Command     = 0xB
+    huc3Result      = 0xC
+    huc3Semaphore   = 0xD
+    huc3IR          = 0xE
+)
+
+// Locations in the memory of the microcontroller.
+const (
+    huc3MinutesAddress      = 0x00 // 3 nibbles.
+    huc3DaysAddress         = 0x03 // 4 nibbles.
+    huc3AlarmMinutesAddress = 0x58 // 3 nibbles.
+    huc3AlarmDaysAddress    = 0x5B // 4 nibbles.
+    huc3AlarmEnableAddress  = 0x5F
+
+    // Size of the clock footer appended to the RAM in saves, as written by SameBoy: the UNIX
+    // time of the save as a little-endian 64-bit value, the minutes, days, alarm minutes and
+    // alarm days as little-endian 16-bit values, and the alarm enable byte.
+    huc3FooterSize = 17
+
+    minutesPerDay = 24 * 60
+)
+
+// huc3Clock counts the minutes of the day and days, driven by emulated time as the MBC3 clock.
+type huc3Clock struct {
+    minutes int
+    days    uint16
+
+    // T-cycles elapsed in the current minute, and T-cycles per machine cycle.
+    cycles  int
+    ratio   int
+
+    // Host time of the save the clock was loaded from.
+    savedAt time.Time
+}
+
+func newHuC3(h *Header, rom []byte) *huc3 {
+
+    c := &huc3{rom: rom, romBank: 1, clock: huc3Clock{ratio: scheduler.NormalSpeedRatio}}
+    if h.Type.RAM {
+        c.ram = make([]byte, h.RAMSize)
+    }
+    return c
+}
+
+// ramOffset returns the offset in RAM of address, or -1 if RAM is missing.
+func (c *huc3) ramOffset(address uint16) int {
+
+    if len(c.ram) == 0 {
+        return -1
+    }
+    return (int(c.ramBank) * RAMBankSize + int(address - 0xA000)) % len(c.ram)
+}
+
+func (c *huc3) Read(address uint16) byte {
+
+    switch {
+    case address < 0x4000:
+        return c.rom[address]
+    case address < 0x8000:
+        return romBank(c.rom, int(c.romBank))[address - 0x4000]
+    case address < 0xA000 || address >= 0xC000:
+        return openBus
+    }
+
+    switch c.mode {
+    case huc3RAMReadOnly, huc3RAM:
+        if offset := c.ramOffset(address); offset >= 0 {
+            return c.ram[offset]
+        }
+    case huc3Result:
+        return c.command << 4 | c.result
+    case huc3Semaphore:
+        return 0x01
+    case huc3IR:
+        return c.infrared.read()
+    }
+    return openBus
+}
+
+func (c *huc3) Write(address uint16, value byte) {
+
+    switch {
+    case address < 0x2000:
+        c.mode = value & 0x0F
+
+    case address < 0x4000:
+        c.romBank = value & 0x7F
+
+    case address < 0x6000:
+        c.ramBank = value & 0x0F
+
+    case address >= 0xA000 && address < 0xC000:
+        switch c.mode {
+        case huc3RAM:
+            if offset := c.ramOffset(address); offset >= 0 {
+                c.ram[offset] = value
+            }
+        case huc3Command:
+            c.execute(value >> 4 & 0x07, value & 0x0F)
+        case huc3IR:
+            c.infrared.write(value)
+        }
+    }
+}
+
+// execute runs a command of the microcontroller.
+func (c *huc3) execute(command, argument byte) {
+
+    c.command = command
+
+    switch command {
+    case 0x1:
+        c.result = c.readNibble(c.address)
+        c.address++
+    case 0x2:
+        c.writeNibble(c.address, argument)
+    case 0x3:
+        c.writeNibble(c.address, argument)
+        c.address++
+    case 0x4:
+        c.address = c.address & 0xF0 | argument
+    case 0x5:
+        c.address = c.address & 0x0F | argument << 4
+    }
+}
+
+// readNibble returns the nibble at address in the memory of the microcontroller, the
+// clock being read live.
+func (c *huc3) readNibble(address byte) byte {
+
+    switch {
+    case address < huc3DaysAddress:
+        return byte(c.clock.minutes >> (4 * address)) & 0x0F
+    case address < huc3DaysAddress + 4:
+        return byte(c.clock.days >> (4 * (address - huc3DaysAddress))) & 0x0F
+    }
+    return c.memory[address]
+}
+
+func (c *huc3) writeNibble(address, value byte) {
+
+    switch {
+    case address < huc3DaysAddress:
+        shift := 4 * address
+        c.clock.minutes = c.clock.minutes &^ (0x0F << shift) | int(value) << shift
+    case address < huc3DaysAddress + 4:
+        shift := 4 * (address - huc3DaysAddress)
+        c.clock.days = c.clock.days &^ (0x0F << shift) | uint16(value) << shift
+    default:
+        c.memory[address] = value
+    }
+}
+
+// Page returns the ROM page in the banks currently mapped.
+func (c *huc3) Page(page byte) []byte {
+
+    switch {
+    case page < 0x40:
+        return c.rom[int(page) << 8 :][:0x100]
+    case page < 0x80:
+        return romBank(c.rom, int(c.romBank))[int(page - 0x40) << 8 :][:0x100]
+    }
+    return nil
+}
+
+// Tick advances the clock by the machine cycles the CPU has used.
+func (c *huc3) Tick(cycles int) {
+    c.clock.advance(cycles * c.clock.ratio)
+}
+
+// SetDoubleSpeed makes machine cycles last half the time: the clock runs on real time.
+func (c *huc3) SetDoubleSpeed(on bool) {
+    c.clock.ratio = scheduler.NormalSpeedRatio
+    if on {
+        c.clock.ratio = scheduler.DoubleSpeedRatio
+    }
+}
+
+// advance moves the clock forward by T-cycles.
+func (t *huc3Clock) advance(cycles int) {
+
+    t.cycles += cycles
+    if t.cycles < 60 * cyclesPerSecond {
+        return
+    }
+
+    t.minutes += t.cycles / (60 * cyclesPerSecond)
+    t.cycles %= 60 * cyclesPerSecond
+
+    t.days += uint16(t.minutes / minutesPerDay)
+    t.minutes %= minutesPerDay
+}
+
+func (c *huc3) syncClock(now time.Time) {
+
+    if c.clock.savedAt.IsZero() {
+        return
+    }
+    if elapsed := now.Sub(c.clock.savedAt) / time.Second; elapsed > 0 {
+        c.clock.advance(int(elapsed) * cyclesPerSecond)
+    }
+    c.clock.savedAt = now
+}
+
+// alarm returns the nibbles at address in the memory of the microcontroller, least significant first.
+func (c *huc3) alarm(address byte, nibbles int) uint16 {
+
+    value := uint16(0)
+    for i := nibbles - 1; i >= 0; i-- {
+        value = value << 4 | uint16(c.memory[int(address) + i])
+    }
+    return value
+}
+
+func (c *huc3) setAlarm(address byte, nibbles int, value uint16) {
+    for i := 0; i < nibbles; i++ {
+        c.memory[int(address) + i] = byte(value >> (4 * i)) & 0x0F
+    }
+}
+
+// SaveData returns the RAM, followed by the clock footer.
+func (c *huc3) SaveData() []byte {
+
+    footer := make([]byte, huc3FooterSize)
+    binary.LittleEndian.PutUint64(footer[0:], uint64(now().Unix()))
+    binary.LittleEndian.PutUint16(footer[8:], uint16(c.clock.minutes))
+    binary.LittleEndian.PutUint16(footer[10:], c.clock.days)
+    binary.LittleEndian.PutUint16(footer[12:], c.alarm(huc3AlarmMinutesAddress, 3))
+    binary.LittleEndian.PutUint16(footer[14:], c.alarm(huc3AlarmDaysAddress, 4))
+    footer[16] = c.memory[huc3AlarmEnableAddress] & 0x01
+
+    return append(saveRAM(c.ram), footer...)
+}
+
+// LoadSaveData restores the RAM, and the clock if the footer is present.
+func (c *huc3) LoadSaveData(data []byte) error {
+
+    switch len(data) - len(c.ram) {
+    case huc3FooterSize:
+        footer := data[len(c.ram):]
+        c.clock.savedAt = time.Unix(int64(binary.LittleEndian.Uint64(footer[0:])), 0)
+        c.clock.minutes = int(binary.LittleEndian.Uint16(footer[8:])) % 0x1000
+        c.clock.days = binary.LittleEndian.Uint16(footer[10:])
+        c.clock.cycles = 0
+        c.setAlarm(huc3AlarmMinutesAddress, 3, binary.LittleEndian.Uint16(footer[12:]))
+        c.setAlarm(huc3AlarmDaysAddress, 4, binary.LittleEndian.Uint16(footer[14:]))
+        c.memory[huc3AlarmEnableAddress] = footer[16] & 0x01
+        data = data[:len(c.ram)]
+    case 0:
+    default:
+        return fmt.Errorf("cartridge: save data is %d bytes, expected %d with a %d bytes clock footer",
+            len(data), len(c.ram), huc3FooterSize)
+    }
+
+    return loadRAM(c.ram, data)
+}
@@ -0,0 +1,206 @@
+package cartridge
+
+import (
+    "encoding/binary"
+    "testing"
+    "time"
+)
+
+// Machine cycles in one minute at normal speed.
+const machineCyclesPerMinute = 60 * machineCyclesPerSecond
+
+// huc3Execute sends a command to the HuC3 microcontroller and returns the result.
+func huc3Execute(c *Cartridge, command, argument byte) byte {
+
+    c.Write(0x0000, huc3Command)
+    c.Write(0xA000, command << 4 | argument)
+    c.Write(0x0000, huc3Result)
+    return c.Read(0xA000)
+}
+
+// readHuC3Clock returns the minutes and days of the HuC3 clock, read through commands.
+func readHuC3Clock(c *Cartridge) (minutes, days int) {
+
+    huc3Execute(c, 0x4, 0x0)
+    huc3Execute(c, 0x5, 0x0)
+
+    for i := 0; i < 3; i++ {
+        minutes |= int(huc3Execute(c, 0x1, 0) & 0x0F) << (4 * i)
+    }
+    for i := 0; i < 4; i++ {
+        days |= int(huc3Execute(c, 0x1, 0) & 0x0F) << (4 * i)
+    }
+    return minutes, days
+}
+
+// writeHuC3Clock sets the minutes and days of the HuC3 clock through commands.
+func writeHuC3Clock(c *Cartridge, minutes, days int) {
+
+    huc3Execute(c, 0x4, 0x0)
+    huc3Execute(c, 0x5, 0x0)
+
+    for i := 0; i < 3; i++ {
+        huc3Execute(c, 0x3, byte(minutes >> (4 * i)) & 0x0F)
+    }
+    for i := 0; i < 4; i++ {
+        huc3Execute(c, 0x3, byte(days >> (4 * i)) & 0x0F)
+    }
+}
+
+func TestHuC3MapsRAMByMode(t *testing.T) {
+
+    // Given: 2 MiB of ROM, 32 KiB of RAM.
+    c := newTestCartridge(t, 0xFE, 0x06, 0x03)
+
+    // When
+    c.Write(0x0000, huc3RAM)
+    c.Write(0x4000, 0x02)
+    c.Write(0xA000, 0x42)
+
+    // Then
+    c.Write(0x0000, huc3RAMReadOnly)
+    if got := c.Read(0xA000); got != 0x42 {
+        t.Error("RAM bank 2 should read 0x42, got ", got)
+    }
+
+    // When: read-only.
+    c.Write(0xA000, 0x24)
+
+    // Then
+    if got := c.Read(0xA000); got != 0x42 {
+        t.Error("RAM should not be written in read-only mode, got ", got)
+    }
+
+    // When
+    c.Write(0x2000, 0x45)
+
+    // Then
+    if got := c.Read(0x4000); got != 0x45 {
+        t.Error("0x4000 should read bank 0x45, got ", got)
+    }
+}
+
+func TestHuC3ReadsAndWritesMemory(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0xFE, 0x05, 0x03)
+
+    // When: address 0x10, write 3 nibbles.
+    huc3Execute(c, 0x4, 0x0)
+    huc3Execute(c, 0x5, 0x1)
+    huc3Execute(c, 0x3, 0xA)
+    huc3Execute(c, 0x2, 0xB)
+    huc3Execute(c, 0x3, 0xC)
+
+    // Then: 0x2 does not increment the address, 0x3 does.
+    huc3Execute(c, 0x4, 0x0)
+    for _, want := range []byte{0xA, 0xC} {
+        if got := huc3Execute(c, 0x1, 0x0); got != 0x10 | want {
+            t.Errorf("Read should return command 1 and 0x%X, got 0x%02X", want, got)
+        }
+    }
+
+    // Then
+    c.Write(0x0000, huc3Semaphore)
+    if got := c.Read(0xA000) & 0x01; got != 1 {
+        t.Error("Semaphore should read ready, got ", got)
+    }
+}
+
+func TestHuC3ClockFollowsEmulatedTime(t *testing.T) {
+
+    // Given: day 3, 23:59.
+    c := newTestCartridge(t, 0xFE, 0x05, 0x03)
+    writeHuC3Clock(c, minutesPerDay - 1, 3)
+
+    // When
+    c.Tick(machineCyclesPerMinute - 1)
+
+    // Then
+    if minutes, days := readHuC3Clock(c); minutes != minutesPerDay - 1 || days != 3 {
+        t.Error("Clock should not have moved, got ", minutes, " minutes, day ", days)
+    }
+
+    // When
+    c.Tick(1)
+
+    // Then
+    if minutes, days := readHuC3Clock(c); minutes != 0 || days != 4 {
+        t.Error("Clock should be at day 4, 00:00, got ", minutes, " minutes, day ", days)
+    }
+}
+
+func TestHuC3InfraredPort(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0xFE, 0x05, 0x03)
+    led := false
+    c.OnIR(func(on bool) { led = on })
+
+    // When
+    c.Write(0x0000, huc3IR)
+    c.Write(0xA000, 0x01)
+    c.SetIRLight(true)
+
+    // Then
+    if !led {
+        t.Error("IR LED should be on")
+    }
+    if got := c.Read(0xA000); got != 0xC1 {
+        t.Error("IR port should read 0xC1 when seeing light, got ", got)
+    }
+}
+
+func TestHuC3SavesClock(t *testing.T) {
+
+    // Given: alarm set at 0x58-0x5F.
+    setNow(t, time.Unix(1700000000, 0))
+    c := newTestCartridge(t, 0xFE, 0x05, 0x03)
+    writeHuC3Clock(c, 600, 0x1234)
+    huc3Execute(c, 0x4, 0x8)
+    huc3Execute(c, 0x5, 0x5)
+    for _, nibble := range []byte{0x0, 0x2, 0x0, 0x5, 0x0, 0x0, 0x0, 0x1} {
+        huc3Execute(c, 0x3, nibble)
+    }
+
+    // When
+    data := c.SaveData()
+
+    // Then
+    if len(data) != 0x8000 + huc3FooterSize {
+        t.Fatal("Save should be 32 KiB of RAM and a 17 bytes footer, got ", len(data), " bytes")
+    }
+    footer := data[0x8000:]
+    if got := binary.LittleEndian.Uint64(footer); got != 1700000000 {
+        t.Error("Footer timestamp should be 1700000000, got ", got)
+    }
+    for i, want := range []uint16{600, 0x1234, 0x020, 0x5} {
+        if got := binary.LittleEndian.Uint16(footer[8 + i * 2:]); got != want {
+            t.Errorf("Footer value %d should be 0x%X, got 0x%X", i, want, got)
+        }
+    }
+    if footer[16] != 1 {
+        t.Error("Alarm should be enabled, got ", footer[16])
+    }
+
+    // When: loaded a day, an hour and a minute later.
+    setNow(t, time.Unix(1700000000 + 86400 + 3660, 0))
+    loaded := newTestCartridge(t, 0xFE, 0x05, 0x03)
+    loaded.SyncClock = true
+    if err := loaded.LoadSaveData(data); err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if minutes, days := readHuC3Clock(loaded); minutes != 661 || days != 0x1235 {
+        t.Error("Clock should be at day 0x1235, 11:01, got ", minutes, " minutes, day ", days)
+    }
+    huc3Execute(loaded, 0x4, 0x8)
+    huc3Execute(loaded, 0x5, 0x5)
+    if got := huc3Execute(loaded, 0x1, 0x0) & 0x0F; got != 0x0 {
+        t.Error("Alarm minutes should be restored, got ", got)
+    }
+    if got := huc3Execute(loaded, 0x1, 0x0) & 0x0F; got != 0x2 {
+        t.Error("Alarm minutes should be restored, got ", got)
+    }
+}
@@ -0,0 +1,71 @@
+package cartridge
+
+// IRHandler is called with the new state of an IR LED every time it changes.
+//
+// HuC1 and HuC3 cartridges have an IR port of their own, driven as the CGB RP register:
+// Cartridge and mmu.MMU both implement mmu.IRPort, so a host links either port the same way.
+type IRHandler = func(on bool)
+
+// irPort is implemented by mappers with an IR LED and sensor.
+type irPort interface {
+    onIR(handler IRHandler)
+    setIRLight(on bool)
+}
+
+// HasIR reports whether the cartridge has an IR port.
+func (c *Cartridge) HasIR() bool {
+    _, ok := c.Mapper.(irPort)
+    return ok
+}
+
+// OnIR subscribes handler to the IR LED of the cartridge, if it has one.
+// Handlers are called in the order they subscribed, from the goroutine running the CPU.
+func (c *Cartridge) OnIR(handler IRHandler) {
+    if m, ok := c.Mapper.(irPort); ok {
+        m.onIR(handler)
+    }
+}
+
+// SetIRLight tells the cartridge whether its IR sensor sees light.
+// It is ignored by cartridges without IR port.
+func (c *Cartridge) SetIRLight(on bool) {
+    if m, ok := c.Mapper.(irPort); ok {
+        m.setIRLight(on)
+    }
+}
+
+// infrared is the IR port of HuC cartridges, mapped at 0xA000-0xBFFF in IR mode:
+// reads return 0xC1 when the sensor sees light, 0xC0 otherwise, bit 0 of writes drives the LED.
+type infrared struct {
+    led         bool
+    light       bool
+    handlers    []IRHandler
+}
+
+func (r *infrared) read() byte {
+    if r.light {
+        return 0xC1
+    }
+    return 0xC0
+}
+
+func (r *infrared) write(value byte) {
+
+    led := value & 0x01 != 0
+    if led == r.led {
+        return
+    }
+    r.led = led
+
+    for _, handler := range r.handlers {
+        handler(led)
+    }
+}
+
+func (r *infrared) onIR(handler IRHandler) {
+    r.handlers = append(r.handlers, handler)
+}
+
+func (r *infrared) setIRLight(on bool) {
+    r.light = on
+}
@@ -0,0 +1,141 @@
+package cartridge
+
+import "bytes"
+
+// mbc1 is the MBC1 controller, up to 2 MiB of ROM and 32 KiB of RAM:
+//
+// 0x0000-0x1FFF: RAM enable, 0x0A in the lower nibble enables it.
+// 0x2000-0x3FFF: BANK1, lower 5 bits of the ROM bank at 0x4000. 0 selects 1.
+// 0x4000-0x5FFF: BANK2, 2 bits: upper bits of the ROM bank, or RAM bank.
+// 0x6000-0x7FFF: Mode. In mode 1, BANK2 also applies to 0x0000-0x3FFF and to RAM.
+//
+// MBC1M multicarts wire BANK2 to bits 4-5 of the ROM bank instead of 5-6, and leave
+// bit 4 of BANK1 unconnected: each game of the compilation is 256 KiB.
+type mbc1 struct {
+    rom []byte
+    ram []byte
+
+    ramEnabled  bool
+    bank1       byte
+    bank2       byte
+    mode        byte
+
+    // BANK1 bits wired to the ROM bank, and where BANK2 goes.
+    bank1Mask   byte
+    bank2Shift  uint
+}
+
+func newMBC1(h *Header, rom []byte) *mbc1 {
+
+    c := &mbc1{rom: rom, bank1: 1, bank1Mask: 0x1F, bank2Shift: 5}
+    if h.Type.RAM {
+        c.ram = make([]byte, h.RAMSize)
+    }
+
+    if isMBC1M(rom) {
+        c.bank1Mask = 0x0F
+        c.bank2Shift = 4
+    }
+    return c
+}
+
+// isMBC1M reports whether rom is an MBC1M multicart: 1 MiB compilations whose games each
+// have their own header, so the Nintendo logo is found again at the start of the second game.
+func isMBC1M(rom []byte) bool {
+
+    if len(rom) != 64 * ROMBankSize {
+        return false
+    }
+
+    logo := 0x10 * ROMBankSize + logoAddress
+    return bytes.Equal(rom[logo : logo + len(Logo)], Logo[:])
+}
+
+// lowBank returns the ROM bank mapped at 0x0000-0x3FFF.
+func (c *mbc1) lowBank() []byte {
+    if c.mode == 0 {
+        return romBank(c.rom, 0)
+    }
+    return romBank(c.rom, int(c.bank2) << c.bank2Shift)
+}
+
+// highBank returns the ROM bank mapped at 0x4000-0x7FFF.
+func (c *mbc1) highBank() []byte {
+    return romBank(c.rom, int(c.bank2) << c.bank2Shift | int(c.bank1 & c.bank1Mask))
+}
+
+// ramOffset returns the offset in RAM of address, or -1 if RAM is disabled or missing.
+func (c *mbc1) ramOffset(address uint16) int {
+
+    if !c.ramEnabled || len(c.ram) == 0 {
+        return -1
+    }
+
+    offset := int(address - 0xA000)
+    if c.mode == 1 {
+        offset += int(c.bank2) * RAMBankSize
+    }
+    return offset % len(c.ram)
+}
+
+func (c *mbc1) Read(address uint16) byte {
+
+    switch {
+    case address < 0x4000:
+        return c.lowBank()[address]
+    case address < 0x8000:
+        return c.highBank()[address - 0x4000]
+    case address >= 0xA000 && address < 0xC000:
+        if offset := c.ramOffset(address); offset >= 0 {
+            return c.ram[offset]
+        }
+    }
+    return openBus
+}
+
+func (c *mbc1) Write(address uint16, value byte) {
+
+    switch {
+    case address < 0x2000:
+        c.ramEnabled = value & 0x0F == 0x0A
+
+    case address < 0x4000:
+        // The bank-0 quirk: a 0 in the 5 bits selects bank 1, even when they are not all
+        // wired, so banks 0x20, 0x40 and 0x60 can't be mapped at 0x4000.
+        c.bank1 = value & 0x1F
+        if c.bank1 == 0 {
+            c.bank1 = 1
+        }
+
+    case address < 0x6000:
+        c.bank2 = value & 0x03
+
+    case address < 0x8000:
+        c.mode = value & 0x01
+
+    case address >= 0xA000 && address < 0xC000:
+        if offset := c.ramOffset(address); offset >= 0 {
+            c.ram[offset] = value
+        }
+    }
+}
+
+// Page returns the ROM page in the banks currently mapped.
+func (c *mbc1) Page(page byte) []byte {
+
+    switch {
+    case page < 0x40:
+        return c.lowBank()[int(page) << 8 :][:0x100]
+    case page < 0x80:
+        return c.highBank()[int(page - 0x40) << 8 :][:0x100]
+    }
+    return nil
+}
+
+func (c *mbc1) SaveData() []byte {
+    return saveRAM(c.ram)
+}
+
+func (c *mbc1) LoadSaveData(data []byte) error {
+    return loadRAM(c.ram, data)
+}
@@ -0,0 +1,180 @@
+package cartridge
+
+import (
+    "testing"
+
+    "cgbemu/src/mmu"
+)
+
+// newTestCartridge builds a ROM with buildROM and loads it.
+func newTestCartridge(t *testing.T, cartridgeType, romSizeCode, ramSizeCode byte) *Cartridge {
+
+    c, err := New(buildROM(t, cartridgeType, romSizeCode, ramSizeCode))
+    if err != nil {
+        t.Fatal(err)
+    }
+    return c
+}
+
+func TestMBC1SwitchesROMBanks(t *testing.T) {
+
+    tests := []struct {
+        bank1, bank2    byte
+        expected        byte
+    }{
+        {0x05, 0x00, 0x05},
+        {0x00, 0x00, 0x01}, // Bank 0 selects bank 1.
+        {0x1F, 0x00, 0x1F},
+        {0x00, 0x01, 0x21}, // 0x20 can't be mapped either.
+        {0x12, 0x03, 0x72},
+        {0xE3, 0x00, 0x03}, // Upper bits are ignored.
+    }
+
+    for _, test := range tests {
+
+        // Given: 2 MiB.
+        c := newTestCartridge(t, 0x01, 0x06, 0x00)
+
+        // When
+        c.Write(0x2000, test.bank1)
+        c.Write(0x4000, test.bank2)
+
+        // Then
+        if got := c.Read(0x4000); got != test.expected {
+            t.Errorf("BANK1 0x%02X, BANK2 0x%02X: 0x4000 should read bank 0x%02X, got 0x%02X", test.bank1, test.bank2, test.expected, got)
+        }
+        if got := c.Read(0x0000); got != 0x00 {
+            t.Errorf("BANK1 0x%02X, BANK2 0x%02X: 0x0000 should read bank 0 in mode 0, got 0x%02X", test.bank1, test.bank2, got)
+        }
+    }
+}
+
+func TestMBC1WrapsBanksAroundROMSize(t *testing.T) {
+
+    // Given: 128 KiB, 8 banks.
+    c := newTestCartridge(t, 0x01, 0x02, 0x00)
+
+    // When
+    c.Write(0x3FFF, 0x0D)
+
+    // Then
+    if got := c.Read(0x4000); got != 0x05 {
+        t.Error("Bank 0x0D should wrap to bank 5, got ", got)
+    }
+}
+
+func TestMBC1ModeSelectMapsBANK2AtZero(t *testing.T) {
+
+    // Given: 2 MiB.
+    c := newTestCartridge(t, 0x01, 0x06, 0x00)
+    c.Write(0x4000, 0x02)
+
+    // When
+    c.Write(0x6000, 0x01)
+
+    // Then
+    if got := c.Read(0x0000); got != 0x40 {
+        t.Error("0x0000 should read bank 0x40 in mode 1, got ", got)
+    }
+    if page := c.Page(0x00); page == nil || page[0] != 0x40 {
+        t.Error("Page 0x00 should be in bank 0x40 in mode 1")
+    }
+}
+
+func TestMBC1RAMEnable(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0x03, 0x01, 0x02)
+
+    // When: disabled by default.
+    c.Write(0xA000, 0x42)
+
+    // Then
+    if got := c.Read(0xA000); got != 0xFF {
+        t.Error("Disabled RAM should read 0xFF, got ", got)
+    }
+
+    // When
+    c.Write(0x0000, 0x3A)
+    c.Write(0xA000, 0x42)
+
+    // Then
+    if got := c.Read(0xA000); got != 0x42 {
+        t.Error("Enabled RAM should read 0x42, got ", got)
+    }
+
+    // When: any value other than 0x0A in the lower nibble disables RAM.
+    c.Write(0x1FFF, 0x0B)
+
+    // Then
+    if got := c.Read(0xA000); got != 0xFF {
+        t.Error("Disabled RAM should read 0xFF, got ", got)
+    }
+}
+
+func TestMBC1RAMBanksInMode1(t *testing.T) {
+
+    // Given: 32 KiB of RAM.
+    c := newTestCartridge(t, 0x03, 0x01, 0x03)
+    c.Write(0x0000, 0x0A)
+    c.Write(0x4000, 0x02)
+    c.Write(0xA000, 0x22)
+
+    // When: mode 1 maps the RAM bank in BANK2.
+    c.Write(0x6000, 0x01)
+    c.Write(0xA000, 0x33)
+    c.Write(0x6000, 0x00)
+
+    // Then
+    if got := c.Read(0xA000); got != 0x22 {
+        t.Error("RAM bank 0 should read 0x22 in mode 0, got ", got)
+    }
+    c.Write(0x6000, 0x01)
+    if got := c.Read(0xA000); got != 0x33 {
+        t.Error("RAM bank 2 should read 0x33 in mode 1, got ", got)
+    }
+}
+
+func TestMBC1MulticartIsDetected(t *testing.T) {
+
+    // Given: 1 MiB, with a second header at bank 0x10.
+    rom := buildROM(t, 0x01, 0x05, 0x00)
+    copy(rom[0x10 * ROMBankSize + logoAddress:], Logo[:])
+    fixChecksums(rom)
+
+    c, err := New(rom)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // When: BANK2 goes to bits 4-5, bit 4 of BANK1 is not wired.
+    c.Write(0x2000, 0x12)
+    c.Write(0x4000, 0x01)
+    c.Write(0x6000, 0x01)
+
+    // Then
+    if got := c.Read(0x4000); got != 0x12 {
+        t.Error("0x4000 should read bank 0x12, got ", got)
+    }
+    if got := c.Read(0x0000); got != 0x10 {
+        t.Error("0x0000 should read bank 0x10, got ", got)
+    }
+}
+
+func TestMBC1OnMMU(t *testing.T) {
+
+    // Given
+    bus := mmu.New()
+    bus.LoadCartridge(newTestCartridge(t, 0x01, 0x02, 0x00))
+
+    // When: writes to ROM go to the MBC registers.
+    bus.Write(0x2000, 0x03)
+
+    // Then
+    if got := bus.Read(0x4000); got != 0x03 {
+        t.Error("0x4000 should read bank 3, got ", got)
+    }
+    if got := bus.Read(0x2000); got != 0x00 {
+        t.Error("ROM at 0x2000 should not be overwritten, got ", got)
+    }
+}
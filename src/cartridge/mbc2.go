@@ -0,0 +1,88 @@
+package cartridge
+
+// mbc2RAMSize is the size of the RAM built into MBC2: 512 half-bytes, one per byte here.
+const mbc2RAMSize = 512
+
+// mbc2 is the MBC2 controller, up to 256 KiB of ROM and its own 512×4-bit RAM.
+// Both registers are at 0x0000-0x3FFF, selected by bit 8 of the address:
+//
+// Bit 8 clear: RAM enable, 0x0A in the lower nibble enables it.
+// Bit 8 set:   ROM bank at 0x4000, 4 bits. 0 selects 1.
+//
+// The RAM only decodes 9 address bits: it is mirrored across 0xA000-0xBFFF, and its
+// upper nibble is not wired and reads as 1s.
+type mbc2 struct {
+    rom []byte
+    ram []byte
+
+    ramEnabled  bool
+    romBank     byte
+}
+
+func newMBC2(h *Header, rom []byte) *mbc2 {
+    // The RAM size in the header is 0, the RAM is always there.
+    return &mbc2{rom: rom, ram: make([]byte, mbc2RAMSize), romBank: 1}
+}
+
+func (c *mbc2) Read(address uint16) byte {
+
+    switch {
+    case address < 0x4000:
+        return c.rom[address]
+    case address < 0x8000:
+        return romBank(c.rom, int(c.romBank))[address - 0x4000]
+    case address >= 0xA000 && address < 0xC000:
+        if c.ramEnabled {
+            return c.ram[address % mbc2RAMSize] | 0xF0
+        }
+    }
+    return openBus
+}
+
+func (c *mbc2) Write(address uint16, value byte) {
+
+    switch {
+    case address < 0x4000 && address & 0x0100 == 0:
+        c.ramEnabled = value & 0x0F == 0x0A
+
+    case address < 0x4000:
+        c.romBank = value & 0x0F
+        if c.romBank == 0 {
+            c.romBank = 1
+        }
+
+    case address >= 0xA000 && address < 0xC000:
+        if c.ramEnabled {
+            c.ram[address % mbc2RAMSize] = value & 0x0F
+        }
+    }
+}
+
+// Page returns the ROM page in the banks currently mapped.
+func (c *mbc2) Page(page byte) []byte {
+
+    switch {
+    case page < 0x40:
+        return c.rom[int(page) << 8 :][:0x100]
+    case page < 0x80:
+        return romBank(c.rom, int(c.romBank))[int(page - 0x40) << 8 :][:0x100]
+    }
+    return nil
+}
+
+// SaveData returns the RAM, one half-byte in the lower nibble of each byte.
+func (c *mbc2) SaveData() []byte {
+    return saveRAM(c.ram)
+}
+
+// LoadSaveData restores the RAM, ignoring the upper nibbles which some emulators save as 1s.
+func (c *mbc2) LoadSaveData(data []byte) error {
+
+    if err := loadRAM(c.ram, data); err != nil {
+        return err
+    }
+    for i := range c.ram {
+        c.ram[i] &= 0x0F
+    }
+    return nil
+}
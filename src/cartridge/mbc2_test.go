@@ -0,0 +1,106 @@
+package cartridge
+
+import (
+    "testing"
+)
+
+func TestMBC2SelectsRegistersWithAddressBit8(t *testing.T) {
+
+    // Given: 256 KiB.
+    c := newTestCartridge(t, 0x06, 0x03, 0x00)
+
+    // When: bit 8 clear, RAM enable.
+    c.Write(0x2000, 0x05)
+
+    // Then
+    if got := c.Read(0x4000); got != 0x01 {
+        t.Error("Bit 8 clear should not switch ROM banks, got bank ", got)
+    }
+
+    // When: bit 8 set, ROM bank.
+    c.Write(0x2100, 0x05)
+
+    // Then
+    if got := c.Read(0x4000); got != 0x05 {
+        t.Error("0x4000 should read bank 5, got ", got)
+    }
+
+    // When
+    c.Write(0x0100, 0x00)
+
+    // Then: bank 0 selects bank 1.
+    if got := c.Read(0x4000); got != 0x01 {
+        t.Error("Bank 0 should select bank 1, got ", got)
+    }
+
+    // When
+    c.Write(0x3EFF, 0x0A)
+
+    // Then
+    c.Write(0xA000, 0x03)
+    if got := c.Read(0xA000); got != 0xF3 {
+        t.Error("RAM should be enabled, got ", got)
+    }
+}
+
+func TestMBC2RAMIsHalfBytesMirrored(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0x06, 0x01, 0x00)
+    c.Write(0x0000, 0x0A)
+
+    // When
+    c.Write(0xA1FF, 0xA5)
+
+    // Then: only the lower nibble is stored, the upper one reads as 1s.
+    if got := c.Read(0xA1FF); got != 0xF5 {
+        t.Error("0xA1FF should read 0xF5, got ", got)
+    }
+
+    // Then: 512 bytes mirrored across 0xA000-0xBFFF.
+    for _, address := range []uint16{0xA3FF, 0xB1FF, 0xBFFF} {
+        if got := c.Read(address); got != 0xF5 {
+            t.Errorf("0x%04X should mirror 0xA1FF, got 0x%02X", address, got)
+        }
+    }
+
+    // When
+    c.Write(0x0000, 0x00)
+
+    // Then
+    if got := c.Read(0xA1FF); got != openBus {
+        t.Error("Disabled RAM should read open bus, got ", got)
+    }
+}
+
+func TestMBC2SavesRAM(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0x06, 0x01, 0x00)
+    c.Write(0x0000, 0x0A)
+    c.Write(0xA010, 0x07)
+
+    // When
+    data := c.SaveData()
+
+    // Then
+    if len(data) != 512 || data[0x10] != 0x07 {
+        t.Fatal("Save should be the 512 half-bytes of RAM, got ", len(data), " bytes")
+    }
+
+    // When: saves with the upper nibbles set load too.
+    data[0x11] = 0xF9
+    loaded := newTestCartridge(t, 0x06, 0x01, 0x00)
+    if err := loaded.LoadSaveData(data); err != nil {
+        t.Fatal(err)
+    }
+    loaded.Write(0x0000, 0x0A)
+
+    // Then
+    if got := loaded.Read(0xA010); got != 0xF7 {
+        t.Error("0xA010 should be restored, got ", got)
+    }
+    if got := loaded.Read(0xA011); got != 0xF9 {
+        t.Error("0xA011 should be restored, got ", got)
+    }
+}
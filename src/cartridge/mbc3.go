@@ -0,0 +1,157 @@
+package cartridge
+
+import (
+    "fmt"
+    "time"
+)
+
+// mbc3 is the MBC3 controller, up to 2 MiB of ROM, 32 KiB of RAM and a real time clock:
+//
+// 0x0000-0x1FFF: RAM and RTC enable, 0x0A in the lower nibble enables them.
+// 0x2000-0x3FFF: ROM bank at 0x4000, 7 bits. 0 selects 1.
+// 0x4000-0x5FFF: RAM bank 0x00-0x03, or RTC register 0x08-0x0C, mapped at 0xA000.
+// 0x6000-0x7FFF: RTC latch, see rtc.go.
+type mbc3 struct {
+    rom []byte
+    ram []byte
+
+    // nil on cartridges without a timer.
+    clock *rtc
+
+    enabled     bool
+    romBank     byte
+    ramBank     byte
+}
+
+func newMBC3(h *Header, rom []byte) *mbc3 {
+
+    c := &mbc3{rom: rom, romBank: 1}
+    if h.Type.RAM {
+        c.ram = make([]byte, h.RAMSize)
+    }
+    if h.Type.Timer {
+        c.clock = newRTC()
+    }
+    return c
+}
+
+// isRTC reports whether an RTC register is mapped at 0xA000.
+func (c *mbc3) isRTC() bool {
+    return c.clock != nil && c.ramBank >= rtcSeconds && c.ramBank <= rtcDaysHigh
+}
+
+// ramOffset returns the offset in RAM of address, or -1 if RAM is disabled, missing or not mapped.
+func (c *mbc3) ramOffset(address uint16) int {
+
+    if !c.enabled || len(c.ram) == 0 || c.ramBank > 0x07 {
+        return -1
+    }
+    return (int(c.ramBank) * RAMBankSize + int(address - 0xA000)) % len(c.ram)
+}
+
+func (c *mbc3) Read(address uint16) byte {
+
+    switch {
+    case address < 0x4000:
+        return c.rom[address]
+    case address < 0x8000:
+        return romBank(c.rom, int(c.romBank))[address - 0x4000]
+    case address >= 0xA000 && address < 0xC000:
+        if c.enabled && c.isRTC() {
+            return c.clock.read(c.ramBank)
+        }
+        if offset := c.ramOffset(address); offset >= 0 {
+            return c.ram[offset]
+        }
+    }
+    return openBus
+}
+
+func (c *mbc3) Write(address uint16, value byte) {
+
+    switch {
+    case address < 0x2000:
+        c.enabled = value & 0x0F == 0x0A
+
+    case address < 0x4000:
+        c.romBank = value & 0x7F
+        if c.romBank == 0 {
+            c.romBank = 1
+        }
+
+    case address < 0x6000:
+        c.ramBank = value & 0x0F
+
+    case address < 0x8000:
+        if c.clock != nil {
+            c.clock.writeLatch(value)
+        }
+
+    case address >= 0xA000 && address < 0xC000:
+        if c.enabled && c.isRTC() {
+            c.clock.write(c.ramBank, value)
+        } else if offset := c.ramOffset(address); offset >= 0 {
+            c.ram[offset] = value
+        }
+    }
+}
+
+// Page returns the ROM page in the banks currently mapped.
+func (c *mbc3) Page(page byte) []byte {
+
+    switch {
+    case page < 0x40:
+        return c.rom[int(page) << 8 :][:0x100]
+    case page < 0x80:
+        return romBank(c.rom, int(c.romBank))[int(page - 0x40) << 8 :][:0x100]
+    }
+    return nil
+}
+
+// Tick advances the clock, if any.
+func (c *mbc3) Tick(cycles int) {
+    if c.clock != nil {
+        c.clock.Tick(cycles)
+    }
+}
+
+// SetDoubleSpeed keeps the clock on real time at double speed.
+func (c *mbc3) SetDoubleSpeed(on bool) {
+    if c.clock != nil {
+        c.clock.SetDoubleSpeed(on)
+    }
+}
+
+// SaveData returns the RAM, followed by the RTC footer on cartridges with a timer.
+func (c *mbc3) SaveData() []byte {
+
+    data := saveRAM(c.ram)
+    if c.clock != nil {
+        data = append(data, c.clock.footer(now())...)
+    }
+    return data
+}
+
+// LoadSaveData restores the RAM, and the clock if the RTC footer is present.
+func (c *mbc3) LoadSaveData(data []byte) error {
+
+    if c.clock != nil {
+        switch len(data) - len(c.ram) {
+        case rtcFooterSize, rtcShortFooterSize:
+            c.clock.loadFooter(data[len(c.ram):])
+            data = data[:len(c.ram)]
+        case 0:
+        default:
+            return fmt.Errorf("cartridge: save data is %d bytes, expected %d with a %d bytes RTC footer",
+                len(data), len(c.ram), rtcFooterSize)
+        }
+    }
+
+    return loadRAM(c.ram, data)
+}
+
+func (c *mbc3) syncClock(now time.Time) {
+    if c.clock != nil {
+        c.clock.syncClock(now)
+    }
+}
@@ -0,0 +1,353 @@
+package cartridge
+
+import (
+    "bytes"
+    "encoding/binary"
+    "testing"
+    "time"
+
+    "cgbemu/src/mmu"
+)
+
+// Machine cycles in one second at normal speed.
+const machineCyclesPerSecond = cyclesPerSecond / 4
+
+// readRTC latches the clock and returns the 5 registers.
+func readRTC(c *Cartridge) [5]byte {
+
+    c.Write(0x6000, 0x00)
+    c.Write(0x6000, 0x01)
+
+    var registers [5]byte
+    for i := range registers {
+        c.Write(0x4000, byte(rtcSeconds + i))
+        registers[i] = c.Read(0xA000)
+    }
+    return registers
+}
+
+// writeRTC sets the 5 registers.
+func writeRTC(c *Cartridge, registers [5]byte) {
+    for i, value := range registers {
+        c.Write(0x4000, byte(rtcSeconds + i))
+        c.Write(0xA000, value)
+    }
+}
+
+// newClockCartridge returns an enabled MBC3+TIMER+RAM+BATTERY cartridge with 32 KiB of RAM.
+func newClockCartridge(t *testing.T) *Cartridge {
+    c := newTestCartridge(t, 0x10, 0x06, 0x03)
+    c.Write(0x0000, 0x0A)
+    return c
+}
+
+func TestMBC3SwitchesBanks(t *testing.T) {
+
+    // Given: 2 MiB of ROM, 32 KiB of RAM.
+    c := newTestCartridge(t, 0x13, 0x06, 0x03)
+    c.Write(0x0000, 0x0A)
+
+    // When
+    c.Write(0x2000, 0x00)
+
+    // Then
+    if got := c.Read(0x4000); got != 0x01 {
+        t.Error("Bank 0 should select bank 1, got ", got)
+    }
+
+    // When
+    c.Write(0x2000, 0xFF)
+
+    // Then: 7 bits.
+    if got := c.Read(0x4000); got != 0x7F {
+        t.Error("0x4000 should read bank 0x7F, got ", got)
+    }
+
+    // When
+    for bank := byte(0); bank < 4; bank++ {
+        c.Write(0x4000, bank)
+        c.Write(0xA000, 0x10 + bank)
+    }
+
+    // Then
+    for bank := byte(0); bank < 4; bank++ {
+        c.Write(0x4000, bank)
+        if got := c.Read(0xA000); got != 0x10 + bank {
+            t.Errorf("RAM bank %d should read 0x%02X, got 0x%02X", bank, 0x10 + bank, got)
+        }
+    }
+}
+
+func TestMBC3ClockIsLatched(t *testing.T) {
+
+    // Given
+    c := newClockCartridge(t)
+    readRTC(c)
+
+    // When
+    c.Tick(machineCyclesPerSecond)
+
+    // Then: the latched registers don't move until the next latch.
+    c.Write(0x4000, rtcSeconds)
+    if got := c.Read(0xA000); got != 0 {
+        t.Error("Latched seconds should still be 0, got ", got)
+    }
+    if got := readRTC(c); got != [5]byte{1, 0, 0, 0, 0} {
+        t.Error("Clock should be at 1 second, got ", got)
+    }
+
+    // When: writing 0x01 without 0x00 first does not latch.
+    c.Tick(machineCyclesPerSecond)
+    c.Write(0x6000, 0x01)
+
+    // Then
+    c.Write(0x4000, rtcSeconds)
+    if got := c.Read(0xA000); got != 1 {
+        t.Error("Latched seconds should still be 1, got ", got)
+    }
+}
+
+func TestMBC3ClockRollsOver(t *testing.T) {
+
+    // Given: day 511, 23:59:59.
+    c := newClockCartridge(t)
+    writeRTC(c, [5]byte{59, 59, 23, 0xFF, 0x01})
+
+    // When
+    c.Tick(machineCyclesPerSecond)
+
+    // Then: the day counter overflows and sets the carry.
+    if got := readRTC(c); got != [5]byte{0, 0, 0, 0, rtcCarry} {
+        t.Error("Clock should be at day 0 with carry, got ", got)
+    }
+
+    // When: the carry stays set until written.
+    writeRTC(c, [5]byte{59, 59, 23, 0x00, rtcCarry})
+    c.Tick(machineCyclesPerSecond)
+
+    // Then
+    if got := readRTC(c); got != [5]byte{0, 0, 0, 1, rtcCarry} {
+        t.Error("Clock should be at day 1 with carry, got ", got)
+    }
+}
+
+func TestMBC3ClockOutOfRangeValuesWrap(t *testing.T) {
+
+    // Given
+    c := newClockCartridge(t)
+    writeRTC(c, [5]byte{62, 0, 0, 0, 0})
+
+    // When
+    c.Tick(2 * machineCyclesPerSecond)
+
+    // Then: 62, 63, then 0 without carrying into the minutes.
+    if got := readRTC(c); got != [5]byte{0, 0, 0, 0, 0} {
+        t.Error("Clock should be at 0, got ", got)
+    }
+}
+
+func TestMBC3ClockHalts(t *testing.T) {
+
+    // Given
+    c := newClockCartridge(t)
+    writeRTC(c, [5]byte{10, 0, 0, 0, rtcHalt})
+
+    // When
+    c.Tick(10 * machineCyclesPerSecond)
+
+    // Then
+    if got := readRTC(c); got != [5]byte{10, 0, 0, 0, rtcHalt} {
+        t.Error("Halted clock should not move, got ", got)
+    }
+}
+
+func TestMBC3ClockFollowsEmulatedTimeAtDoubleSpeed(t *testing.T) {
+
+    // Given: machine cycles last half the time.
+    bus := mmu.New()
+    c := newClockCartridge(t)
+    bus.LoadCartridge(c)
+    bus.AddComponent(c)
+    c.SetDoubleSpeed(true)
+
+    // When
+    bus.Tick(machineCyclesPerSecond)
+
+    // Then
+    if got := readRTC(c); got[0] != 0 {
+        t.Error("Half a second should have passed, got ", got[0], " seconds")
+    }
+
+    // When
+    bus.Tick(machineCyclesPerSecond)
+
+    // Then
+    if got := readRTC(c); got[0] != 1 {
+        t.Error("One second should have passed, got ", got[0], " seconds")
+    }
+}
+
+func TestMBC3WritingSecondsRestartsTheSecond(t *testing.T) {
+
+    // Given
+    c := newClockCartridge(t)
+    c.Tick(machineCyclesPerSecond - 1)
+
+    // When
+    writeRTC(c, [5]byte{0, 0, 0, 0, 0})
+    c.Tick(1)
+
+    // Then
+    if got := readRTC(c); got[0] != 0 {
+        t.Error("The second should have restarted, got ", got[0], " seconds")
+    }
+}
+
+// setNow makes the host time t for the test.
+func setNow(t *testing.T, at time.Time) {
+    saved := now
+    now = func() time.Time { return at }
+    t.Cleanup(func() { now = saved })
+}
+
+func TestMBC3SavesRTCFooter(t *testing.T) {
+
+    // Given
+    savedAt := time.Unix(1700000000, 0)
+    setNow(t, savedAt)
+
+    c := newClockCartridge(t)
+    c.Write(0x4000, 0x00)
+    c.Write(0xA000, 0x42)
+    writeRTC(c, [5]byte{1, 2, 3, 4, 0x01})
+    readRTC(c)
+    writeRTC(c, [5]byte{5, 6, 7, 8, 0x00})
+
+    // When
+    data := c.SaveData()
+
+    // Then
+    if len(data) != 0x8000 + rtcFooterSize {
+        t.Fatal("Save should be 32 KiB of RAM and a 48 bytes footer, got ", len(data), " bytes")
+    }
+    if data[0] != 0x42 {
+        t.Error("Save should start with RAM, got ", data[0])
+    }
+
+    footer := data[0x8000:]
+    want := []uint32{5, 6, 7, 8, 0, 1, 2, 3, 4, 1}
+    for i, value := range want {
+        if got := binary.LittleEndian.Uint32(footer[i * 4:]); got != value {
+            t.Errorf("Footer value %d should be %d, got %d", i, value, got)
+        }
+    }
+    if got := binary.LittleEndian.Uint64(footer[40:]); got != uint64(savedAt.Unix()) {
+        t.Error("Footer timestamp should be ", savedAt.Unix(), ", got ", got)
+    }
+}
+
+func TestMBC3LoadsRTCFooter(t *testing.T) {
+
+    for _, size := range []int{rtcFooterSize, rtcShortFooterSize} {
+
+        // Given: a footer as written by other emulators.
+        footer := make([]byte, size)
+        for i, value := range []uint32{5, 6, 7, 8, 0, 1, 2, 3, 4, 1} {
+            binary.LittleEndian.PutUint32(footer[i * 4:], value)
+        }
+        data := append(bytes.Repeat([]byte{0x42}, 0x8000), footer...)
+
+        c := newClockCartridge(t)
+
+        // When
+        if err := c.LoadSaveData(data); err != nil {
+            t.Fatal(err)
+        }
+
+        // Then: the latched registers are restored.
+        c.Write(0x4000, rtcSeconds)
+        if got := c.Read(0xA000); got != 1 {
+            t.Error(size, " bytes footer: latched seconds should be 1, got ", got)
+        }
+        if got := readRTC(c); got != [5]byte{5, 6, 7, 8, 0} {
+            t.Error(size, " bytes footer: clock should be restored, got ", got)
+        }
+        c.Write(0x4000, 0x00)
+        if got := c.Read(0xA000); got != 0x42 {
+            t.Error(size, " bytes footer: RAM should be restored, got ", got)
+        }
+    }
+}
+
+func TestMBC3SyncsClockToHostTime(t *testing.T) {
+
+    // Given: saved at 00:00:00 on day 510.
+    setNow(t, time.Unix(1700000000, 0))
+    saved := newClockCartridge(t)
+    writeRTC(saved, [5]byte{0, 0, 0, 0xFE, 0x01})
+    data := saved.SaveData()
+
+    // When: loaded 2 days, 1 hour, 1 minute and 1 second later.
+    setNow(t, time.Unix(1700000000 + 2 * 86400 + 3661, 0))
+
+    c := newClockCartridge(t)
+    c.SyncClock = true
+    if err := c.LoadSaveData(data); err != nil {
+        t.Fatal(err)
+    }
+
+    // Then: the day counter overflowed.
+    if got := readRTC(c); got != [5]byte{1, 1, 1, 0x00, rtcCarry} {
+        t.Error("Clock should be at day 0, 01:01:01 with carry, got ", got)
+    }
+
+    // When: without sync, the clock stays where it was saved.
+    c = newClockCartridge(t)
+    if err := c.LoadSaveData(data); err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if got := readRTC(c); got != [5]byte{0, 0, 0, 0xFE, 0x01} {
+        t.Error("Clock should not have moved, got ", got)
+    }
+}
+
+func TestMBC3RejectsSaveOfWrongSize(t *testing.T) {
+
+    // Given
+    c := newClockCartridge(t)
+
+    // When
+    err := c.LoadSaveData(make([]byte, 0x8000 + 10))
+
+    // Then
+    if err == nil {
+        t.Error("Save data of the wrong size should be an error")
+    }
+}
+
+func TestBatteryRAMIsSaved(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0x03, 0x01, 0x02)
+    c.Write(0x0000, 0x0A)
+    c.Write(0xA123, 0x42)
+
+    // When
+    loaded := newTestCartridge(t, 0x03, 0x01, 0x02)
+    if err := loaded.LoadSaveData(c.SaveData()); err != nil {
+        t.Fatal(err)
+    }
+    loaded.Write(0x0000, 0x0A)
+
+    // Then
+    if got := loaded.Read(0xA123); got != 0x42 {
+        t.Error("RAM should be restored, got ", got)
+    }
+
+    // Without battery, nothing is saved.
+    if data := newTestCartridge(t, 0x02, 0x01, 0x02).SaveData(); data != nil {
+        t.Error("Cartridges without battery should not save, got ", len(data), " bytes")
+    }
+}
@@ -0,0 +1,109 @@
+package cartridge
+
+// mbc5 is the MBC5 controller, up to 8 MiB of ROM and 128 KiB of RAM:
+//
+// 0x0000-0x1FFF: RAM enable, only 0x0A enables it.
+// 0x2000-0x2FFF: Lower 8 bits of the ROM bank at 0x4000. Bank 0 can be mapped.
+// 0x3000-0x3FFF: Bit 8 of the ROM bank.
+// 0x4000-0x5FFF: RAM bank, 4 bits. On rumble cartridges bit 3 drives the motor instead.
+type mbc5 struct {
+    rom []byte
+    ram []byte
+
+    ramEnabled  bool
+    romBank     int
+    ramBank     byte
+
+    // nil on cartridges without rumble.
+    motor       *motor
+}
+
+func newMBC5(h *Header, rom []byte) *mbc5 {
+
+    c := &mbc5{rom: rom, romBank: 1}
+    if h.Type.RAM {
+        c.ram = make([]byte, h.RAMSize)
+    }
+    if h.Type.Rumble {
+        c.motor = &motor{}
+    }
+    return c
+}
+
+// ramOffset returns the offset in RAM of address, or -1 if RAM is disabled or missing.
+func (c *mbc5) ramOffset(address uint16) int {
+
+    if !c.ramEnabled || len(c.ram) == 0 {
+        return -1
+    }
+    return (int(c.ramBank) * RAMBankSize + int(address - 0xA000)) % len(c.ram)
+}
+
+func (c *mbc5) Read(address uint16) byte {
+
+    switch {
+    case address < 0x4000:
+        return c.rom[address]
+    case address < 0x8000:
+        return romBank(c.rom, c.romBank)[address - 0x4000]
+    case address >= 0xA000 && address < 0xC000:
+        if offset := c.ramOffset(address); offset >= 0 {
+            return c.ram[offset]
+        }
+    }
+    return openBus
+}
+
+func (c *mbc5) Write(address uint16, value byte) {
+
+    switch {
+    case address < 0x2000:
+        // Unlike MBC1 and MBC3, all 8 bits are decoded.
+        c.ramEnabled = value == 0x0A
+
+    case address < 0x3000:
+        c.romBank = c.romBank &^ 0xFF | int(value)
+
+    case address < 0x4000:
+        c.romBank = c.romBank & 0xFF | int(value & 0x01) << 8
+
+    case address < 0x6000:
+        if c.motor != nil {
+            c.motor.set(value & 0x08 != 0)
+            c.ramBank = value & 0x07
+        } else {
+            c.ramBank = value & 0x0F
+        }
+
+    case address >= 0xA000 && address < 0xC000:
+        if offset := c.ramOffset(address); offset >= 0 {
+            c.ram[offset] = value
+        }
+    }
+}
+
+// Page returns the ROM page in the banks currently mapped.
+func (c *mbc5) Page(page byte) []byte {
+
+    switch {
+    case page < 0x40:
+        return c.rom[int(page) << 8 :][:0x100]
+    case page < 0x80:
+        return romBank(c.rom, c.romBank)[int(page - 0x40) << 8 :][:0x100]
+    }
+    return nil
+}
+
+func (c *mbc5) onRumble(handler RumbleHandler) {
+    if c.motor != nil {
+        c.motor.onRumble(handler)
+    }
+}
+
+func (c *mbc5) SaveData() []byte {
+    return saveRAM(c.ram)
+}
+
+func (c *mbc5) LoadSaveData(data []byte) error {
+    return loadRAM(c.ram, data)
+}
@@ -0,0 +1,140 @@
+package cartridge
+
+import (
+    "testing"
+)
+
+func TestMBC5SwitchesROMBanks(t *testing.T) {
+
+    tests := []struct {
+        low, high   byte
+        expected    int
+    }{
+        {0x05, 0x00, 0x005},
+        {0x00, 0x00, 0x000}, // Bank 0 can be mapped at 0x4000.
+        {0xFF, 0x00, 0x0FF},
+        {0x00, 0x01, 0x100},
+        {0x23, 0xFF, 0x123}, // Upper bits of the high register are ignored.
+    }
+
+    for _, test := range tests {
+
+        // Given: 8 MiB, banks numbered on 2 bytes.
+        rom := buildROM(t, 0x19, 0x08, 0x00)
+        for bank := 0; bank < len(rom) / ROMBankSize; bank++ {
+            rom[bank * ROMBankSize + 1] = byte(bank >> 8)
+        }
+        fixChecksums(rom)
+        c, err := New(rom)
+        if err != nil {
+            t.Fatal(err)
+        }
+
+        // When
+        c.Write(0x2000, test.low)
+        c.Write(0x3000, test.high)
+
+        // Then
+        if got := int(c.Read(0x4001)) << 8 | int(c.Read(0x4000)); got != test.expected {
+            t.Errorf("Low 0x%02X, high 0x%02X: 0x4000 should read bank 0x%03X, got 0x%03X", test.low, test.high, test.expected, got)
+        }
+        if got := c.Page(0x40)[0]; got != byte(test.expected) {
+            t.Errorf("Low 0x%02X, high 0x%02X: page 0x40 should be in bank 0x%03X, got 0x%02X", test.low, test.high, test.expected, got)
+        }
+    }
+}
+
+func TestMBC5SwitchesRAMBanks(t *testing.T) {
+
+    // Given: 128 KiB of RAM.
+    c := newTestCartridge(t, 0x1B, 0x01, 0x04)
+    c.Write(0x0000, 0x0A)
+
+    // When
+    for bank := byte(0); bank < 16; bank++ {
+        c.Write(0x4000, bank)
+        c.Write(0xBFFF, 0x10 + bank)
+    }
+
+    // Then
+    for bank := byte(0); bank < 16; bank++ {
+        c.Write(0x4000, bank)
+        if got := c.Read(0xBFFF); got != 0x10 + bank {
+            t.Errorf("RAM bank %d should read 0x%02X, got 0x%02X", bank, 0x10 + bank, got)
+        }
+    }
+}
+
+func TestMBC5EnablesRAMWithExactly0x0A(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0x1A, 0x01, 0x02)
+
+    // When
+    c.Write(0x0000, 0x1A)
+    c.Write(0xA000, 0x42)
+
+    // Then
+    if got := c.Read(0xA000); got != openBus {
+        t.Error("RAM should be disabled by 0x1A, got ", got)
+    }
+
+    // When
+    c.Write(0x0000, 0x0A)
+    c.Write(0xA000, 0x42)
+
+    // Then
+    if got := c.Read(0xA000); got != 0x42 {
+        t.Error("RAM should be enabled by 0x0A, got ", got)
+    }
+}
+
+func TestMBC5ReportsRumble(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0x1E, 0x01, 0x03)
+    c.Write(0x0000, 0x0A)
+
+    var events []bool
+    c.OnRumble(func(on bool) { events = append(events, on) })
+
+    // When: bit 3 pulses the motor, writing the same value twice is not a change.
+    for _, value := range []byte{0x08, 0x08, 0x00, 0x0B, 0x0B} {
+        c.Write(0x4000, value)
+    }
+
+    // Then
+    if len(events) != 3 || !events[0] || events[1] || !events[2] {
+        t.Error("Rumble events should be on, off, on, got ", events)
+    }
+
+    // Then: bit 3 is not a RAM bank bit.
+    c.Write(0xA000, 0x42)
+    c.Write(0x4000, 0x0B)
+    if got := c.Read(0xA000); got != 0x42 {
+        t.Error("RAM bank 3 should be mapped with the motor on, got ", got)
+    }
+}
+
+func TestMBC5WithoutRumbleUsesBit3ForRAM(t *testing.T) {
+
+    // Given
+    c := newTestCartridge(t, 0x1B, 0x01, 0x04)
+    c.Write(0x0000, 0x0A)
+
+    events := 0
+    c.OnRumble(func(on bool) { events++ })
+
+    // When
+    c.Write(0x4000, 0x08)
+    c.Write(0xA000, 0x42)
+    c.Write(0x4000, 0x00)
+
+    // Then
+    if got := c.Read(0xA000); got == 0x42 {
+        t.Error("RAM bank 8 should not be bank 0")
+    }
+    if events != 0 {
+        t.Error("Cartridges without rumble should not report events, got ", events)
+    }
+}
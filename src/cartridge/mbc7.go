@@ -0,0 +1,152 @@
+package cartridge
+
+// mbc7 is the MBC7 controller, up to 2 MiB of ROM, a 2-axis accelerometer and a 93LC56
+// EEPROM instead of RAM:
+//
+// 0x0000-0x1FFF: RAM enable 1, 0x0A enables it.
+// 0x2000-0x3FFF: ROM bank at 0x4000, 8 bits. Bank 0 can be mapped.
+// 0x4000-0x5FFF: RAM enable 2, 0x40 enables it.
+//
+// With both enabled, 0xA000-0xAFFF holds 16 registers, selected by address bits 4-7:
+//
+// 0x0: Write 0x55 to erase the latched accelerometer values, to 0x8000.
+// 0x1: Write 0xAA to latch the accelerometer values, once erased.
+// 0x2-0x5: Latched X, low then high byte, then latched Y.
+// 0x6: Reads 0x00.
+// 0x8: EEPROM lines, see eeprom.
+//
+// Everything else reads 0xFF.
+type mbc7 struct {
+    rom []byte
+
+    enabled1    bool
+    enabled2    bool
+    romBank     byte
+
+    // Latched accelerometer values, and whether they were erased since the last latch.
+    x, y        uint16
+    erased      bool
+
+    eeprom      *eeprom
+
+    // Tilt set by the host, in g.
+    tiltX       float64
+    tiltY       float64
+}
+
+const (
+    // Accelerometer values: at rest, and change for 1 g.
+    accelerometerCenter = 0x81D0
+    accelerometerG      = 0x70
+)
+
+func newMBC7(h *Header, rom []byte) *mbc7 {
+    // The RAM size in the header is 0, the EEPROM is always there.
+    return &mbc7{rom: rom, romBank: 1, x: 0x8000, y: 0x8000, eeprom: newEEPROM()}
+}
+
+func (c *mbc7) Read(address uint16) byte {
+
+    switch {
+    case address < 0x4000:
+        return c.rom[address]
+    case address < 0x8000:
+        return romBank(c.rom, int(c.romBank))[address - 0x4000]
+    case address >= 0xA000 && address < 0xB000 && c.enabled1 && c.enabled2:
+        return c.readRegister(address >> 4 & 0x0F)
+    }
+    return openBus
+}
+
+func (c *mbc7) readRegister(register uint16) byte {
+
+    switch register {
+    case 0x2:
+        return byte(c.x)
+    case 0x3:
+        return byte(c.x >> 8)
+    case 0x4:
+        return byte(c.y)
+    case 0x5:
+        return byte(c.y >> 8)
+    case 0x6:
+        return 0x00
+    case 0x8:
+        return c.eeprom.read()
+    }
+    return openBus
+}
+
+func (c *mbc7) Write(address uint16, value byte) {
+
+    switch {
+    case address < 0x2000:
+        c.enabled1 = value == 0x0A
+        if !c.enabled1 {
+            c.enabled2 = false
+        }
+
+    case address < 0x4000:
+        c.romBank = value
+
+    case address < 0x6000:
+        c.enabled2 = c.enabled1 && value == 0x40
+
+    case address >= 0xA000 && address < 0xB000 && c.enabled1 && c.enabled2:
+        c.writeRegister(address >> 4 & 0x0F, value)
+    }
+}
+
+func (c *mbc7) writeRegister(register uint16, value byte) {
+
+    switch register {
+    case 0x0:
+        if value == 0x55 {
+            c.x, c.y = 0x8000, 0x8000
+            c.erased = true
+        }
+    case 0x1:
+        if value == 0xAA && c.erased {
+            c.x, c.y = c.accelerometer()
+            c.erased = false
+        }
+    case 0x8:
+        c.eeprom.write(value)
+    }
+}
+
+// accelerometer returns the current X and Y values for the tilt.
+func (c *mbc7) accelerometer() (x, y uint16) {
+    return uint16(accelerometerCenter + int(c.tiltX * accelerometerG)),
+        uint16(accelerometerCenter + int(c.tiltY * accelerometerG))
+}
+
+func (c *mbc7) setTilt(x, y float64) {
+    c.tiltX, c.tiltY = x, y
+}
+
+// Page returns the ROM page in the banks currently mapped.
+func (c *mbc7) Page(page byte) []byte {
+
+    switch {
+    case page < 0x40:
+        return c.rom[int(page) << 8 :][:0x100]
+    case page < 0x80:
+        return romBank(c.rom, int(c.romBank))[int(page - 0x40) << 8 :][:0x100]
+    }
+    return nil
+}
+
+// SaveData returns the EEPROM, 128 little-endian words.
+func (c *mbc7) SaveData() []byte {
+    return c.eeprom.save()
+}
+
+func (c *mbc7) LoadSaveData(data []byte) error {
+
+    if err := checkSaveSize(data, eepromSize); err != nil {
+        return err
+    }
+    c.eeprom.load(data)
+    return nil
+}
@@ -0,0 +1,237 @@
+package cartridge
+
+import (
+    "testing"
+)
+
+// newMBC7Cartridge returns an MBC7 cartridge with its registers enabled.
+func newMBC7Cartridge(t *testing.T) *Cartridge {
+
+    c := newTestCartridge(t, 0x22, 0x05, 0x00)
+    c.Write(0x0000, 0x0A)
+    c.Write(0x4000, 0x40)
+    return c
+}
+
+// clockEEPROM sends one bit to the EEPROM with CS high, and returns DO after the rising edge.
+func clockEEPROM(c *Cartridge, bit bool) bool {
+
+    value := byte(0x80)
+    if bit {
+        value |= 0x02
+    }
+    c.Write(0xA080, value)
+    c.Write(0xA080, value | 0x40)
+    return c.Read(0xA080) & 0x01 != 0
+}
+
+// sendEEPROMCommand selects the EEPROM and sends the start bit, opcode, address and data bits.
+func sendEEPROMCommand(c *Cartridge, opcode byte, address byte, data ...uint16) {
+
+    c.Write(0xA080, 0x00)
+    c.Write(0xA080, 0x80)
+
+    clockEEPROM(c, true)
+    for i := 1; i >= 0; i-- {
+        clockEEPROM(c, opcode >> i & 1 != 0)
+    }
+    for i := 7; i >= 0; i-- {
+        clockEEPROM(c, address >> i & 1 != 0)
+    }
+    for _, word := range data {
+        for i := 15; i >= 0; i-- {
+            clockEEPROM(c, word >> i & 1 != 0)
+        }
+    }
+}
+
+// readEEPROM reads words from address.
+func readEEPROM(c *Cartridge, address byte, words int) []uint16 {
+
+    sendEEPROMCommand(c, 0x02, address)
+    if c.Read(0xA080) & 0x01 != 0 {
+        return nil // No dummy bit.
+    }
+
+    data := make([]uint16, words)
+    for i := range data {
+        for bit := 0; bit < 16; bit++ {
+            data[i] <<= 1
+            if clockEEPROM(c, false) {
+                data[i] |= 1
+            }
+        }
+    }
+    c.Write(0xA080, 0x00)
+    return data
+}
+
+func TestMBC7SwitchesROMBanks(t *testing.T) {
+
+    // Given
+    c := newMBC7Cartridge(t)
+
+    // When
+    c.Write(0x2000, 0x00)
+
+    // Then
+    if got := c.Read(0x4000); got != 0x00 {
+        t.Error("0x4000 should read bank 0, got ", got)
+    }
+
+    // When
+    c.Write(0x2000, 0x3F)
+
+    // Then
+    if got := c.Read(0x4000); got != 0x3F {
+        t.Error("0x4000 should read bank 0x3F, got ", got)
+    }
+}
+
+func TestMBC7LatchesAccelerometer(t *testing.T) {
+
+    // Given
+    c := newMBC7Cartridge(t)
+    c.SetTilt(1, -0.5)
+
+    // When
+    c.Write(0xA000, 0x55)
+    c.Write(0xA010, 0xAA)
+
+    // Then
+    x := uint16(c.Read(0xA030)) << 8 | uint16(c.Read(0xA020))
+    y := uint16(c.Read(0xA050)) << 8 | uint16(c.Read(0xA040))
+    if x != 0x81D0 + 0x70 || y != 0x81D0 - 0x38 {
+        t.Errorf("Accelerometer should read 0x%04X, 0x%04X, got 0x%04X, 0x%04X", 0x81D0 + 0x70, 0x81D0 - 0x38, x, y)
+    }
+
+    // When: no latch without erasing first.
+    c.SetTilt(0, 0)
+    c.Write(0xA010, 0xAA)
+
+    // Then
+    if got := c.Read(0xA020); got != 0x40 {
+        t.Error("Latched X should not change, got ", got)
+    }
+
+    // When
+    c.Write(0xA000, 0x55)
+
+    // Then
+    if got := c.Read(0xA030); got != 0x80 {
+        t.Error("Erased X should read 0x80, got ", got)
+    }
+}
+
+func TestMBC7RegistersNeedBothEnables(t *testing.T) {
+
+    // Given
+    c := newMBC7Cartridge(t)
+
+    // When
+    c.Write(0x4000, 0x00)
+
+    // Then
+    if got := c.Read(0xA060); got != openBus {
+        t.Error("Registers should be disabled, got ", got)
+    }
+
+    // When
+    c.Write(0x4000, 0x40)
+
+    // Then
+    if got := c.Read(0xA060); got != 0x00 {
+        t.Error("Register 6 should read 0x00, got ", got)
+    }
+    if got := c.Read(0xB060); got != openBus {
+        t.Error("0xB000-0xBFFF should read open bus, got ", got)
+    }
+}
+
+func TestMBC7EEPROMWritesNeedEWEN(t *testing.T) {
+
+    // Given
+    c := newMBC7Cartridge(t)
+
+    // When: writes are disabled at power on.
+    sendEEPROMCommand(c, 0x01, 0x05, 0x1234)
+    c.Write(0xA080, 0x00)
+
+    // Then
+    if got := readEEPROM(c, 0x05, 1); len(got) != 1 || got[0] != 0xFFFF {
+        t.Errorf("Word 5 should still be erased, got %04X", got)
+    }
+
+    // When
+    sendEEPROMCommand(c, 0x00, 0xC0)
+    sendEEPROMCommand(c, 0x01, 0x05, 0x1234)
+    c.Write(0xA080, 0x00)
+
+    // Then: ready, and sequential reads continue with the next word.
+    if got := c.Read(0xA080) & 0x01; got != 1 {
+        t.Error("DO should report ready, got ", got)
+    }
+    if got := readEEPROM(c, 0x05, 2); len(got) != 2 || got[0] != 0x1234 || got[1] != 0xFFFF {
+        t.Errorf("Words 5-6 should read 1234 FFFF, got %04X", got)
+    }
+}
+
+func TestMBC7EEPROMEraseAndWriteAll(t *testing.T) {
+
+    // Given
+    c := newMBC7Cartridge(t)
+    sendEEPROMCommand(c, 0x00, 0xC0)
+
+    // When: WRAL.
+    sendEEPROMCommand(c, 0x00, 0x40, 0xABCD)
+
+    // Then
+    if got := readEEPROM(c, 0x7F, 2); len(got) != 2 || got[0] != 0xABCD || got[1] != 0xABCD {
+        t.Errorf("Every word should be ABCD, got %04X", got)
+    }
+
+    // When: ERASE.
+    sendEEPROMCommand(c, 0x03, 0x10)
+
+    // Then
+    if got := readEEPROM(c, 0x0F, 3); len(got) != 3 || got[0] != 0xABCD || got[1] != 0xFFFF || got[2] != 0xABCD {
+        t.Errorf("Word 0x10 only should be erased, got %04X", got)
+    }
+
+    // When: EWDS, then ERAL.
+    sendEEPROMCommand(c, 0x00, 0x00)
+    sendEEPROMCommand(c, 0x00, 0x80)
+
+    // Then
+    if got := readEEPROM(c, 0x00, 1); len(got) != 1 || got[0] != 0xABCD {
+        t.Errorf("ERAL should be ignored after EWDS, got %04X", got)
+    }
+}
+
+func TestMBC7SavesEEPROM(t *testing.T) {
+
+    // Given
+    c := newMBC7Cartridge(t)
+    sendEEPROMCommand(c, 0x00, 0xC0)
+    sendEEPROMCommand(c, 0x01, 0x01, 0xBEEF)
+    c.Write(0xA080, 0x00)
+
+    // When
+    data := c.SaveData()
+
+    // Then
+    if len(data) != 256 || data[2] != 0xEF || data[3] != 0xBE {
+        t.Fatal("Save should be the 128 words of EEPROM, little-endian, got ", len(data), " bytes")
+    }
+
+    // When
+    loaded := newMBC7Cartridge(t)
+    if err := loaded.LoadSaveData(data); err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if got := readEEPROM(loaded, 0x01, 1); len(got) != 1 || got[0] != 0xBEEF {
+        t.Errorf("Word 1 should be restored, got %04X", got)
+    }
+}
@@ -0,0 +1,177 @@
+package cartridge
+
+// mmm01 is the MMM01 multicart controller, up to 8 MiB of ROM and 128 KiB of RAM. It starts
+// unmapped, showing the menu in the last 32 KiB of ROM at 0x0000-0x7FFF. The menu writes the
+// location and size of the selected game to the registers, then maps it: from then on, the
+// game sees an MBC1 in its own part of the ROM, the other bits being locked.
+//
+// 0x0000-0x1FFF: Bits 0-3: RAM enable, 0x0A enables it.
+//                Unmapped only: bits 4-5: RAM bank mask, bit 6: maps the game.
+// 0x2000-0x3FFF: Bits 0-4: ROM bank low, the bits in the mask are locked once mapped.
+//                Unmapped only: bits 5-6: ROM bank mid.
+// 0x4000-0x5FFF: Bits 0-1: RAM bank low, the bits in the mask are locked once mapped.
+//                Unmapped only: bits 2-3: RAM bank high, bits 4-5: ROM bank high,
+//                bit 6: locks the MBC1 mode.
+// 0x6000-0x7FFF: Bit 0: MBC1 mode, unless locked.
+//                Unmapped only: bits 2-5: mask of ROM bank low bits 1-4, bit 6: multiplex,
+//                swapping ROM bank mid and RAM bank low.
+type mmm01 struct {
+    rom []byte
+    ram []byte
+
+    mapped      bool
+    ramEnabled  bool
+
+    romLow, romMid, romHigh byte
+    ramLow, ramHigh         byte
+    romMask, ramMask        byte
+
+    mode        byte
+    modeLocked  bool
+    multiplex   bool
+}
+
+func newMMM01(h *Header, rom []byte) *mmm01 {
+
+    c := &mmm01{rom: rom}
+    if h.Type.RAM {
+        c.ram = make([]byte, h.RAMSize)
+    }
+    return c
+}
+
+// banks returns the ROM bank and RAM bank numbers, without the ROM bank low bits.
+func (c *mmm01) banks() (rom, ram int) {
+
+    mid, low := c.romMid, c.ramLow
+    if c.multiplex {
+        mid, low = low, mid
+    }
+
+    ram = int(c.ramHigh) << 2
+    if c.mode == 1 {
+        ram |= int(low)
+    } else {
+        ram |= int(low & c.ramMask)
+    }
+    return int(c.romHigh) << 7 | int(mid) << 5, ram
+}
+
+// lowBank returns the ROM bank mapped at 0x0000-0x3FFF.
+func (c *mmm01) lowBank() []byte {
+
+    if !c.mapped {
+        return romBank(c.rom, len(c.rom) / ROMBankSize - 2)
+    }
+    outer, _ := c.banks()
+    return romBank(c.rom, outer | int(c.romLow & c.romMask))
+}
+
+// highBank returns the ROM bank mapped at 0x4000-0x7FFF.
+func (c *mmm01) highBank() []byte {
+
+    if !c.mapped {
+        return romBank(c.rom, len(c.rom) / ROMBankSize - 1)
+    }
+
+    // The bank-0 quirk of MBC1, on the bits the game controls.
+    low := c.romLow
+    if low &^ c.romMask == 0 {
+        low |= 1
+    }
+    outer, _ := c.banks()
+    return romBank(c.rom, outer | int(low))
+}
+
+// ramOffset returns the offset in RAM of address, or -1 if RAM is disabled or missing.
+func (c *mmm01) ramOffset(address uint16) int {
+
+    if !c.ramEnabled || len(c.ram) == 0 {
+        return -1
+    }
+    _, bank := c.banks()
+    return (bank * RAMBankSize + int(address - 0xA000)) % len(c.ram)
+}
+
+func (c *mmm01) Read(address uint16) byte {
+
+    switch {
+    case address < 0x4000:
+        return c.lowBank()[address]
+    case address < 0x8000:
+        return c.highBank()[address - 0x4000]
+    case address >= 0xA000 && address < 0xC000:
+        if offset := c.ramOffset(address); offset >= 0 {
+            return c.ram[offset]
+        }
+    }
+    return openBus
+}
+
+// locked returns the bits of value in mask once mapped, taking them from current.
+func (c *mmm01) locked(current, value, mask byte) byte {
+    if !c.mapped {
+        return value
+    }
+    return current & mask | value &^ mask
+}
+
+func (c *mmm01) Write(address uint16, value byte) {
+
+    switch {
+    case address < 0x2000:
+        c.ramEnabled = value & 0x0F == 0x0A
+        if !c.mapped {
+            c.ramMask = value >> 4 & 0x03
+            c.mapped = value & 0x40 != 0
+        }
+
+    case address < 0x4000:
+        c.romLow = c.locked(c.romLow, value & 0x1F, c.romMask)
+        if !c.mapped {
+            c.romMid = value >> 5 & 0x03
+        }
+
+    case address < 0x6000:
+        c.ramLow = c.locked(c.ramLow, value & 0x03, c.ramMask)
+        if !c.mapped {
+            c.ramHigh = value >> 2 & 0x03
+            c.romHigh = value >> 4 & 0x03
+            c.modeLocked = value & 0x40 != 0
+        }
+
+    case address < 0x8000:
+        if !c.modeLocked {
+            c.mode = value & 0x01
+        }
+        if !c.mapped {
+            c.romMask = (value >> 2 & 0x0F) << 1
+            c.multiplex = value & 0x40 != 0
+        }
+
+    case address >= 0xA000 && address < 0xC000:
+        if offset := c.ramOffset(address); offset >= 0 {
+            c.ram[offset] = value
+        }
+    }
+}
+
+// Page returns the ROM page in the banks currently mapped.
+func (c *mmm01) Page(page byte) []byte {
+
+    switch {
+    case page < 0x40:
+        return c.lowBank()[int(page) << 8 :][:0x100]
+    case page < 0x80:
+        return c.highBank()[int(page - 0x40) << 8 :][:0x100]
+    }
+    return nil
+}
+
+func (c *mmm01) SaveData() []byte {
+    return saveRAM(c.ram)
+}
+
+func (c *mmm01) LoadSaveData(data []byte) error {
+    return loadRAM(c.ram, data)
+}
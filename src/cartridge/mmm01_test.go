@@ -0,0 +1,83 @@
+package cartridge
+
+import (
+    "testing"
+)
+
+// buildMMM01 returns a 256 KiB MMM01 multicart: banks numbered, no header at 0x0100, and the
+// menu header in the last 32 KiB.
+func buildMMM01(t *testing.T) []byte {
+
+    menu := buildROM(t, 0x0D, 0x00, 0x02)
+
+    rom := make([]byte, 16 * ROMBankSize)
+    for bank := 0; bank < 16; bank++ {
+        rom[bank * ROMBankSize] = byte(bank)
+    }
+    copy(rom[14 * ROMBankSize + 0x0100 : 14 * ROMBankSize + HeaderEnd], menu[0x0100:HeaderEnd])
+    return rom
+}
+
+func TestMMM01IsDetectedFromMenuHeader(t *testing.T) {
+
+    // When
+    c, err := New(buildMMM01(t))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // Then
+    if c.Detection == nil || c.Detection.Mapper != "MMM01" {
+        t.Fatal("Cartridge should be detected as MMM01, got ", c.Detection)
+    }
+    if c.Header.Type.MBC != MMM01 || c.Header.ROMSize != 16 * ROMBankSize {
+        t.Error("Header should be the one of the menu with the whole ROM size, got ", c.Header.Type, ", ", c.Header.ROMSize)
+    }
+
+    // Then: the menu is mapped at start.
+    if got := c.Read(0x0000); got != 14 {
+        t.Error("0x0000 should read bank 14, got ", got)
+    }
+    if got := c.Read(0x4000); got != 15 {
+        t.Error("0x4000 should read bank 15, got ", got)
+    }
+}
+
+func TestMMM01MapsSelectedGame(t *testing.T) {
+
+    // Given
+    c, err := New(buildMMM01(t))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // When: the menu selects the 64 KiB game at bank 4, locks ROM bank bits 2-4 and maps it.
+    c.Write(0x2000, 0x04)
+    c.Write(0x6000, 0x0E << 2)
+    c.Write(0x0000, 0x40)
+
+    // Then
+    if got := c.Read(0x0000); got != 4 {
+        t.Error("0x0000 should read bank 4, got ", got)
+    }
+    if got := c.Read(0x4000); got != 5 {
+        t.Error("Bank 0 of the game should select its bank 1, got ", got)
+    }
+
+    // When: the game only switches within its 4 banks.
+    c.Write(0x2000, 0x1F)
+
+    // Then
+    if got := c.Read(0x4000); got != 7 {
+        t.Error("0x4000 should read bank 7, got ", got)
+    }
+
+    // When: the mapping registers are locked.
+    c.Write(0x6000, 0x00)
+    c.Write(0x2000, 0x02)
+
+    // Then
+    if got := c.Read(0x4000); got != 6 {
+        t.Error("0x4000 should read bank 6, got ", got)
+    }
+}
@@ -0,0 +1,67 @@
+package cartridge
+
+// openBus is read from addresses the cartridge does not drive.
+const openBus = 0xFF
+
+// romBank returns ROM bank n, wrapped around the ROM size as the unconnected bank bits are.
+func romBank(rom []byte, n int) []byte {
+    n %= len(rom) / ROMBankSize
+    return rom[n * ROMBankSize : (n + 1) * ROMBankSize]
+}
+
+// romOnly is a cartridge without MBC: 32 KiB of ROM and up to 8 KiB of RAM, always mapped.
+type romOnly struct {
+    rom []byte
+    ram []byte
+}
+
+func newROMOnly(h *Header, rom []byte) *romOnly {
+
+    c := &romOnly{rom: rom}
+    if h.Type.RAM {
+        c.ram = make([]byte, min(h.RAMSize, RAMBankSize))
+    }
+    return c
+}
+
+func (c *romOnly) Read(address uint16) byte {
+
+    switch {
+    case address < 0x8000:
+        if int(address) < len(c.rom) {
+            return c.rom[address]
+        }
+    case address >= 0xA000 && address < 0xC000:
+        if offset := int(address - 0xA000); offset < len(c.ram) {
+            return c.ram[offset]
+        }
+    }
+    return openBus
+}
+
+func (c *romOnly) Write(address uint16, value byte) {
+
+    if address >= 0xA000 && address < 0xC000 {
+        if offset := int(address - 0xA000); offset < len(c.ram) {
+            c.ram[offset] = value
+        }
+    }
+}
+
+// Page returns the ROM page, which never changes.
+func (c *romOnly) Page(page byte) []byte {
+
+    start := int(page) << 8
+    if page >= 0x80 || start + 0x100 > len(c.rom) {
+        return nil
+    }
+    return c.rom[start : start + 0x100]
+}
+
+func (c *romOnly) SaveData() []byte {
+    return saveRAM(c.ram)
+}
+
+func (c *romOnly) LoadSaveData(data []byte) error {
+    return loadRAM(c.ram, data)
+}
@@ -0,0 +1,237 @@
+package cartridge
+
+import (
+    "encoding/binary"
+    "time"
+
+    "cgbemu/src/scheduler"
+)
+
+// The MBC3 real time clock counts seconds, minutes, hours and a 9-bit day counter from its
+// own 32.768 kHz crystal. Here it is driven by emulated time: it advances with the machine
+// cycles the cartridge is ticked for, so it stays in step with the game when the emulator
+// runs faster or slower than real time, and stops while the emulator is paused.
+//
+// The registers are selected by writing 0x08-0x0C to the RAM bank register, and read from
+// latched copies: writing 0x00 then 0x01 to 0x6000-0x7FFF latches the current time.
+
+// RTC registers, as selected in the RAM bank register.
+const (
+    rtcSeconds  = 0x08
+    rtcMinutes  = 0x09
+    rtcHours    = 0x0A
+    rtcDaysLow  = 0x0B
+    rtcDaysHigh = 0x0C // Bit 0: day bit 8. Bit 6: halt. Bit 7: day counter carry.
+)
+
+const (
+    rtcHalt     = 0x40
+    rtcCarry    = 0x80
+
+    // T-cycles of the base clock in one second, at any CPU speed.
+    cyclesPerSecond = 4194304
+
+    // Size of the RTC footer appended to the RAM in saves, by BGB, VBA-M, SameBoy and mGBA:
+    // the 5 registers, the 5 latched registers, as little-endian 32-bit values, and the
+    // UNIX time of the save as a little-endian 64-bit value. Older saves have a 32-bit time.
+    rtcFooterSize       = 48
+    rtcShortFooterSize  = 44
+)
+
+// rtcRegisters is the content of the 5 RTC registers.
+type rtcRegisters struct {
+    seconds, minutes, hours, daysLow, daysHigh byte
+}
+
+// rtc is the MBC3 clock.
+type rtc struct {
+    time    rtcRegisters
+    latched rtcRegisters
+
+    // The last value written to the latch register, the time is latched on 0x00 then 0x01.
+    latch   byte
+
+    // T-cycles elapsed in the current second, and T-cycles per machine cycle.
+    cycles  int
+    ratio   int
+
+    // Host time of the save the clock was loaded from, see syncClock.
+    savedAt time.Time
+}
+
+func newRTC() *rtc {
+    return &rtc{ratio: scheduler.NormalSpeedRatio, latch: 0xFF}
+}
+
+// Tick advances the clock by the machine cycles the CPU has used.
+func (r *rtc) Tick(cycles int) {
+
+    if r.time.daysHigh & rtcHalt != 0 {
+        return
+    }
+
+    r.cycles += cycles * r.ratio
+    for r.cycles >= cyclesPerSecond {
+        r.cycles -= cyclesPerSecond
+        r.time.tick()
+    }
+}
+
+// SetDoubleSpeed makes machine cycles last half the time: the clock runs on real time.
+func (r *rtc) SetDoubleSpeed(on bool) {
+    r.ratio = scheduler.NormalSpeedRatio
+    if on {
+        r.ratio = scheduler.DoubleSpeedRatio
+    }
+}
+
+// writeLatch latches the time on the 0x00, 0x01 sequence.
+func (r *rtc) writeLatch(value byte) {
+    if r.latch == 0x00 && value == 0x01 {
+        r.latched = r.time
+    }
+    r.latch = value
+}
+
+// read returns the latched register, unused bits read as 0.
+func (r *rtc) read(register byte) byte {
+    switch register {
+    case rtcSeconds:
+        return r.latched.seconds & 0x3F
+    case rtcMinutes:
+        return r.latched.minutes & 0x3F
+    case rtcHours:
+        return r.latched.hours & 0x1F
+    case rtcDaysLow:
+        return r.latched.daysLow
+    default:
+        return r.latched.daysHigh & (rtcCarry | rtcHalt | 0x01)
+    }
+}
+
+// write sets the current value of the register. Writing the seconds restarts the current second.
+func (r *rtc) write(register, value byte) {
+    switch register {
+    case rtcSeconds:
+        r.time.seconds = value & 0x3F
+        r.cycles = 0
+    case rtcMinutes:
+        r.time.minutes = value & 0x3F
+    case rtcHours:
+        r.time.hours = value & 0x1F
+    case rtcDaysLow:
+        r.time.daysLow = value
+    default:
+        r.time.daysHigh = value & (rtcCarry | rtcHalt | 0x01)
+    }
+}
+
+// tick advances the registers by one second. Out of range values written by the game count up
+// to the register size and wrap to 0 without carrying into the next register.
+func (t *rtcRegisters) tick() {
+
+    t.seconds = (t.seconds + 1) & 0x3F
+    if t.seconds != 60 {
+        return
+    }
+    t.seconds = 0
+
+    t.minutes = (t.minutes + 1) & 0x3F
+    if t.minutes != 60 {
+        return
+    }
+    t.minutes = 0
+
+    t.hours = (t.hours + 1) & 0x1F
+    if t.hours != 24 {
+        return
+    }
+    t.hours = 0
+
+    days := t.days() + 1
+    if days > 0x1FF {
+        days = 0
+        t.daysHigh |= rtcCarry
+    }
+    t.setDays(days)
+}
+
+func (t *rtcRegisters) days() int {
+    return int(t.daysHigh & 0x01) << 8 | int(t.daysLow)
+}
+
+func (t *rtcRegisters) setDays(days int) {
+    t.daysLow = byte(days)
+    t.daysHigh = t.daysHigh &^ 0x01 | byte(days >> 8) & 0x01
+}
+
+// advance moves the time forward by the given seconds, as if the clock had been running.
+func (t *rtcRegisters) advance(seconds int64) {
+
+    // Out of range values are first ticked back into range, there are at most a few.
+    for ; seconds > 0 && (t.seconds >= 60 || t.minutes >= 60 || t.hours >= 24); seconds-- {
+        t.tick()
+    }
+    if seconds <= 0 {
+        return
+    }
+
+    total := int64(t.seconds) + 60 * int64(t.minutes) + 3600 * int64(t.hours) + 86400 * int64(t.days()) + seconds
+    days := total / 86400
+    if days > 0x1FF {
+        t.daysHigh |= rtcCarry
+    }
+
+    t.seconds = byte(total % 60)
+    t.minutes = byte(total / 60 % 60)
+    t.hours = byte(total / 3600 % 24)
+    t.setDays(int(days % 0x200))
+}
+
+// syncClock advances the clock by the host time elapsed since the save it was loaded from.
+// A halted clock does not move.
+func (r *rtc) syncClock(now time.Time) {
+
+    if r.savedAt.IsZero() || r.time.daysHigh & rtcHalt != 0 {
+        return
+    }
+
+    if elapsed := int64(now.Sub(r.savedAt) / time.Second); elapsed > 0 {
+        r.time.advance(elapsed)
+    }
+    r.savedAt = now
+}
+
+// footer returns the RTC footer of saves, stamped with the host time.
+func (r *rtc) footer(now time.Time) []byte {
+
+    footer := make([]byte, rtcFooterSize)
+    for i, value := range []byte{
+        r.time.seconds, r.time.minutes, r.time.hours, r.time.daysLow, r.time.daysHigh,
+        r.latched.seconds, r.latched.minutes, r.latched.hours, r.latched.daysLow, r.latched.daysHigh,
+    } {
+        binary.LittleEndian.PutUint32(footer[i * 4:], uint32(value))
+    }
+    binary.LittleEndian.PutUint64(footer[40:], uint64(now.Unix()))
+
+    return footer
+}
+
+// loadFooter restores the clock from an RTC footer, of either size.
+func (r *rtc) loadFooter(footer []byte) {
+
+    registers := make([]byte, 10)
+    for i := range registers {
+        registers[i] = byte(binary.LittleEndian.Uint32(footer[i * 4:]))
+    }
+
+    r.time = rtcRegisters{registers[0], registers[1], registers[2], registers[3], registers[4]}
+    r.latched = rtcRegisters{registers[5], registers[6], registers[7], registers[8], registers[9]}
+    r.cycles = 0
+
+    if len(footer) == rtcFooterSize {
+        r.savedAt = time.Unix(int64(binary.LittleEndian.Uint64(footer[40:])), 0)
+    } else {
+        r.savedAt = time.Unix(int64(binary.LittleEndian.Uint32(footer[40:])), 0)
+    }
+}
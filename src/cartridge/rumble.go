@@ -0,0 +1,41 @@
+package cartridge
+
+// RumbleHandler is called with the new state of the rumble motor every time it changes.
+type RumbleHandler func(on bool)
+
+// rumbler is implemented by mappers driving a rumble motor.
+type rumbler interface {
+    onRumble(handler RumbleHandler)
+}
+
+// OnRumble subscribes handler to the rumble motor of the cartridge, if it has one.
+// Handlers are called in the order they subscribed, from the goroutine running the CPU.
+func (c *Cartridge) OnRumble(handler RumbleHandler) {
+    if m, ok := c.Mapper.(rumbler); ok && c.Header.Type.Rumble {
+        m.onRumble(handler)
+    }
+}
+
+// motor is the rumble motor of a cartridge.
+type motor struct {
+    on          bool
+    handlers    []RumbleHandler
+}
+
+func (m *motor) onRumble(handler RumbleHandler) {
+    m.handlers = append(m.handlers, handler)
+}
+
+// set turns the motor on or off, notifying the handlers of changes only: games pulse the
+// motor by rewriting the bank register, often with the same value.
+func (m *motor) set(on bool) {
+
+    if on == m.on {
+        return
+    }
+    m.on = on
+
+    for _, handler := range m.handlers {
+        handler(on)
+    }
+}
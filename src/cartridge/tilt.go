@@ -0,0 +1,128 @@
+package cartridge
+
+import (
+    "bufio"
+    "fmt"
+    "io"
+    "os"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+// tiltSensor is implemented by mappers with an accelerometer.
+type tiltSensor interface {
+    setTilt(x, y float64)
+}
+
+// HasTiltSensor reports whether the cartridge has an accelerometer, see SetTilt.
+func (c *Cartridge) HasTiltSensor() bool {
+    _, ok := c.Mapper.(tiltSensor)
+    return ok
+}
+
+// SetTilt sets the acceleration measured by the cartridge, in g: positive x when the
+// console is tilted right, positive y when tilted towards the player. 0, 0 is flat.
+// The host sets it every frame, it is read by the game when it latches the accelerometer.
+// It is ignored by cartridges without accelerometer.
+func (c *Cartridge) SetTilt(x, y float64) {
+    if m, ok := c.Mapper.(tiltSensor); ok {
+        m.setTilt(x, y)
+    }
+}
+
+// TiltScript replays the tilt of headless runs, see ParseTiltScript.
+type TiltScript struct {
+    keys []tiltKey
+}
+
+type tiltKey struct {
+    frame   int
+    x, y    float64
+}
+
+// LoadTiltScript reads the tilt script at path, see ParseTiltScript.
+func LoadTiltScript(path string) (*TiltScript, error) {
+
+    f, err := os.Open(path)
+    if err != nil {
+        return nil, err
+    }
+    defer f.Close()
+
+    return ParseTiltScript(f)
+}
+
+// ParseTiltScript reads a tilt script: one "frame x y" line per change of tilt, the tilt
+// holding until the next line. Frames start at 0 and are in increasing order, the tilt is
+// flat before the first line. Blank lines and lines starting with # are ignored:
+//
+//  # Roll right for a second, then back.
+//  60  0.5 0
+//  120 0   0
+func ParseTiltScript(r io.Reader) (*TiltScript, error) {
+
+    s := &TiltScript{}
+    scanner := bufio.NewScanner(r)
+
+    for line := 1; scanner.Scan(); line++ {
+
+        text := strings.TrimSpace(scanner.Text())
+        if text == "" || strings.HasPrefix(text, "#") {
+            continue
+        }
+
+        key, err := parseTiltKey(text)
+        if err != nil {
+            return nil, fmt.Errorf("cartridge: tilt script line %d: %w", line, err)
+        }
+        if n := len(s.keys); n > 0 && key.frame <= s.keys[n - 1].frame {
+            return nil, fmt.Errorf("cartridge: tilt script line %d: frame %d is not after frame %d", line, key.frame, s.keys[n - 1].frame)
+        }
+        s.keys = append(s.keys, key)
+    }
+
+    if err := scanner.Err(); err != nil {
+        return nil, err
+    }
+    return s, nil
+}
+
+func parseTiltKey(text string) (tiltKey, error) {
+
+    fields := strings.Fields(text)
+    if len(fields) != 3 {
+        return tiltKey{}, fmt.Errorf("expected \"frame x y\", got %q", text)
+    }
+
+    frame, err := strconv.Atoi(fields[0])
+    if err != nil || frame < 0 {
+        return tiltKey{}, fmt.Errorf("invalid frame %q", fields[0])
+    }
+    x, err := strconv.ParseFloat(fields[1], 64)
+    if err != nil {
+        return tiltKey{}, fmt.Errorf("invalid x %q", fields[1])
+    }
+    y, err := strconv.ParseFloat(fields[2], 64)
+    if err != nil {
+        return tiltKey{}, fmt.Errorf("invalid y %q", fields[2])
+    }
+
+    return tiltKey{frame, x, y}, nil
+}
+
+// Tilt returns the tilt at frame.
+func (s *TiltScript) Tilt(frame int) (x, y float64) {
+
+    // First key after frame.
+    i := sort.Search(len(s.keys), func(i int) bool { return s.keys[i].frame > frame })
+    if i == 0 {
+        return 0, 0
+    }
+    return s.keys[i - 1].x, s.keys[i - 1].y
+}
+
+// Apply sets the tilt of c for frame, call it before running each frame.
+func (s *TiltScript) Apply(c *Cartridge, frame int) {
+    c.SetTilt(s.Tilt(frame))
+}
@@ -0,0 +1,87 @@
+package cartridge
+
+import (
+    "strings"
+    "testing"
+)
+
+func TestTiltScriptHoldsTiltUntilNextLine(t *testing.T) {
+
+    // Given
+    script, err := ParseTiltScript(strings.NewReader(`
+        # Roll right, then back.
+        60  0.5 0
+
+        120 0   -0.25
+    `))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    tests := []struct {
+        frame   int
+        x, y    float64
+    }{
+        {0, 0, 0},
+        {59, 0, 0},
+        {60, 0.5, 0},
+        {119, 0.5, 0},
+        {120, 0, -0.25},
+        {1000, 0, -0.25},
+    }
+
+    for _, test := range tests {
+
+        // When
+        x, y := script.Tilt(test.frame)
+
+        // Then
+        if x != test.x || y != test.y {
+            t.Errorf("Frame %d: tilt should be %v, %v, got %v, %v", test.frame, test.x, test.y, x, y)
+        }
+    }
+}
+
+func TestTiltScriptDrivesAccelerometer(t *testing.T) {
+
+    // Given
+    script, err := ParseTiltScript(strings.NewReader("10 1 0"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    c := newMBC7Cartridge(t)
+
+    // When
+    script.Apply(c, 10)
+    c.Write(0xA000, 0x55)
+    c.Write(0xA010, 0xAA)
+
+    // Then
+    if got := uint16(c.Read(0xA030)) << 8 | uint16(c.Read(0xA020)); got != 0x81D0 + 0x70 {
+        t.Errorf("X should read 0x%04X, got 0x%04X", 0x81D0 + 0x70, got)
+    }
+}
+
+func TestTiltScriptErrors(t *testing.T) {
+
+    tests := []struct {
+        script  string
+        message string
+    }{
+        {"10 0", "cartridge: tilt script line 1: expected \"frame x y\", got \"10 0\""},
+        {"a 0 0", "cartridge: tilt script line 1: invalid frame \"a\""},
+        {"# Comment\n10 0 y", "cartridge: tilt script line 2: invalid y \"y\""},
+        {"10 0 0\n10 1 1", "cartridge: tilt script line 2: frame 10 is not after frame 10"},
+    }
+
+    for _, test := range tests {
+
+        // When
+        _, err := ParseTiltScript(strings.NewReader(test.script))
+
+        // Then
+        if err == nil || err.Error() != test.message {
+            t.Errorf("Script %q: error should be %q, got %v", test.script, test.message, err)
+        }
+    }
+}
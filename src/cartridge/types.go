@@ -0,0 +1,132 @@
+package cartridge
+
+import (
+    "fmt"
+    "strings"
+)
+
+// MBC is the memory bank controller of a cartridge, the chip mapping ROM and RAM banks.
+type MBC byte
+
+const (
+    UnknownMBC MBC = iota
+    NoMBC
+    MBC1
+    MBC2
+    MBC3
+    MBC5
+    MBC6
+    MBC7
+    MMM01
+    PocketCamera
+    TAMA5
+    HuC1
+    HuC3
+)
+
+func (m MBC) String() string {
+    switch m {
+    case NoMBC:
+        return "ROM"
+    case MBC1:
+        return "MBC1"
+    case MBC2:
+        return "MBC2"
+    case MBC3:
+        return "MBC3"
+    case MBC5:
+        return "MBC5"
+    case MBC6:
+        return "MBC6"
+    case MBC7:
+        return "MBC7"
+    case MMM01:
+        return "MMM01"
+    case PocketCamera:
+        return "POCKET CAMERA"
+    case TAMA5:
+        return "BANDAI TAMA5"
+    case HuC1:
+        return "HuC1"
+    case HuC3:
+        return "HuC3"
+    default:
+        return "UNKNOWN"
+    }
+}
+
+// Type is the cartridge type at 0x0147: the MBC and the hardware on the cartridge.
+type Type struct {
+    Code    byte
+    MBC     MBC
+
+    RAM     bool
+    Battery bool // RAM (and clock) content survives power off, and is saved.
+    Timer   bool // Real time clock.
+    Rumble  bool
+    Sensor  bool // Accelerometer.
+}
+
+// types lists the cartridge types in the header, by code.
+var types = map[byte]Type{
+    0x00: {MBC: NoMBC},
+    0x01: {MBC: MBC1},
+    0x02: {MBC: MBC1, RAM: true},
+    0x03: {MBC: MBC1, RAM: true, Battery: true},
+    0x05: {MBC: MBC2},
+    0x06: {MBC: MBC2, Battery: true},
+    0x08: {MBC: NoMBC, RAM: true},
+    0x09: {MBC: NoMBC, RAM: true, Battery: true},
+    0x0B: {MBC: MMM01},
+    0x0C: {MBC: MMM01, RAM: true},
+    0x0D: {MBC: MMM01, RAM: true, Battery: true},
+    0x0F: {MBC: MBC3, Timer: true, Battery: true},
+    0x10: {MBC: MBC3, Timer: true, RAM: true, Battery: true},
+    0x11: {MBC: MBC3},
+    0x12: {MBC: MBC3, RAM: true},
+    0x13: {MBC: MBC3, RAM: true, Battery: true},
+    0x19: {MBC: MBC5},
+    0x1A: {MBC: MBC5, RAM: true},
+    0x1B: {MBC: MBC5, RAM: true, Battery: true},
+    0x1C: {MBC: MBC5, Rumble: true},
+    0x1D: {MBC: MBC5, Rumble: true, RAM: true},
+    0x1E: {MBC: MBC5, Rumble: true, RAM: true, Battery: true},
+    0x20: {MBC: MBC6},
+    0x22: {MBC: MBC7, Sensor: true, Rumble: true, RAM: true, Battery: true},
+    0xFC: {MBC: PocketCamera},
+    0xFD: {MBC: TAMA5},
+    0xFE: {MBC: HuC3, Timer: true, RAM: true, Battery: true},
+    0xFF: {MBC: HuC1, RAM: true, Battery: true},
+}
+
+// TypeOf returns the cartridge type of code, with MBC set to UnknownMBC if code is not a known type.
+func TypeOf(code byte) Type {
+    t := types[code]
+    t.Code = code
+    return t
+}
+
+// String returns the type as usually listed, e.g. "MBC1+RAM+BATTERY".
+func (t Type) String() string {
+
+    if t.MBC == UnknownMBC {
+        return fmt.Sprintf("UNKNOWN 0x%02X", t.Code)
+    }
+
+    parts := []string{t.MBC.String()}
+    for _, feature := range []struct {
+        present bool
+        name    string
+    }{
+        {t.Timer, "TIMER"},
+        {t.Sensor, "SENSOR"},
+        {t.Rumble, "RUMBLE"},
+        {t.RAM, "RAM"},
+        {t.Battery, "BATTERY"},
+    } {
+        if feature.present {
+            parts = append(parts, feature.name)
+        }
+    }
+    return strings.Join(parts, "+")
+}
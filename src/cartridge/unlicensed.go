@@ -0,0 +1,122 @@
+package cartridge
+
+// sachen is the mapper of Sachen cartridges, MMC1 and MMC2, up to 2 MiB of ROM and no RAM.
+// The boot ROM would refuse their own logo, so they scramble the address lines: reads of
+// 0x0100-0x01FF swap A0 with A6 and A1 with A4. Until the boot ROM is done with the logo,
+// they also set A7, showing it the Nintendo logo stored at 0x0180-0x01FF.
+//
+// The emulator skips the boot ROM, so the cartridge starts unlocked. MMC1 and MMC2 then only
+// differ by the sequence unlocking them, and share this mapper.
+//
+// 0x0000-0x1FFF: Base ROM bank, only written when ROM bank bits 4-5 are set.
+// 0x2000-0x3FFF: ROM bank at 0x4000. 0 selects 1.
+// 0x4000-0x5FFF: Mask, only written when ROM bank bits 4-5 are set: the bits of the ROM
+//                bank taken from the base bank. 0x0000-0x3FFF maps the masked base bank.
+type sachen struct {
+    rom []byte
+
+    base    byte
+    mask    byte
+    bank    byte
+}
+
+func newSachen(rom []byte) *sachen {
+    return &sachen{rom: rom, bank: 1}
+}
+
+// unscrambleSachen returns the ROM address read for address in 0x0100-0x01FF.
+func unscrambleSachen(address uint16) uint16 {
+    return address & 0xFFAC |
+        address & 0x40 >> 6 | address & 0x10 >> 3 |
+        address & 0x02 << 3 | address & 0x01 << 6
+}
+
+// baseWritable reports whether the base bank and mask registers can be written.
+func (c *sachen) baseWritable() bool {
+    return c.bank & 0x30 == 0x30
+}
+
+func (c *sachen) Read(address uint16) byte {
+
+    switch {
+    case address < 0x4000:
+        if address & 0xFF00 == 0x0100 {
+            address = unscrambleSachen(address)
+        }
+        return romBank(c.rom, int(c.base & c.mask))[address]
+    case address < 0x8000:
+        return romBank(c.rom, int(c.bank &^ c.mask | c.base & c.mask))[address - 0x4000]
+    }
+    return openBus
+}
+
+func (c *sachen) Write(address uint16, value byte) {
+
+    switch {
+    case address < 0x2000:
+        if c.baseWritable() {
+            c.base = value
+        }
+    case address < 0x4000:
+        c.bank = value
+        if c.bank == 0 {
+            c.bank = 1
+        }
+    case address < 0x6000:
+        if c.baseWritable() {
+            c.mask = value
+        }
+    }
+}
+
+// Page returns the ROM page in the banks currently mapped, except the scrambled page 0x01.
+func (c *sachen) Page(page byte) []byte {
+
+    switch {
+    case page == 0x01:
+        return nil
+    case page < 0x40:
+        return romBank(c.rom, int(c.base & c.mask))[int(page) << 8 :][:0x100]
+    case page < 0x80:
+        return romBank(c.rom, int(c.bank &^ c.mask | c.base & c.mask))[int(page - 0x40) << 8 :][:0x100]
+    }
+    return nil
+}
+
+// wisdomTreeBankSize is the size of the banks switched by Wisdom Tree cartridges.
+const wisdomTreeBankSize = 0x8000
+
+// wisdomTree is the mapper of Wisdom Tree cartridges, up to 8 MiB of ROM and no RAM. Their
+// header says ROM only: a write to 0x0000-0x3FFF selects, by the lower byte of its address,
+// the 32 KiB bank mapped at 0x0000-0x7FFF.
+type wisdomTree struct {
+    rom []byte
+
+    bank    int
+}
+
+func (c *wisdomTree) offset(address uint16) int {
+    return (c.bank * wisdomTreeBankSize + int(address)) % len(c.rom)
+}
+
+func (c *wisdomTree) Read(address uint16) byte {
+    if address < 0x8000 {
+        return c.rom[c.offset(address)]
+    }
+    return openBus
+}
+
+func (c *wisdomTree) Write(address uint16, value byte) {
+    if address < 0x4000 {
+        c.bank = int(address & 0xFF)
+    }
+}
+
+// Page returns the ROM page in the bank currently mapped.
+func (c *wisdomTree) Page(page byte) []byte {
+    if page >= 0x80 {
+        return nil
+    }
+    start := c.offset(uint16(page) << 8)
+    return c.rom[start : start + 0x100]
+}
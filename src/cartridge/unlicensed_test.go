@@ -0,0 +1,200 @@
+package cartridge
+
+import (
+    "testing"
+)
+
+// buildSachen returns a 1 MiB Sachen ROM, its header stored where the scrambled addresses
+// show it to the boot ROM: the logo with A7 set, the rest of the header without. Another header,
+// with a DECOY title and an MBC5 type, is seen with A7 set, and another logo at 0x0104.
+func buildSachen(t *testing.T, cgb byte) []byte {
+
+    header := buildROM(t, 0x00, 0x00, 0x00)
+    copy(header[titleAddress:], "SACHEN")
+    header[cgbFlagAddress] = cgb
+    fixChecksums(header)
+
+    decoy := buildROM(t, 0x19, 0x00, 0x00)
+    copy(decoy[titleAddress:], "DECOY")
+    fixChecksums(decoy)
+
+    rom := make([]byte, 64 * ROMBankSize)
+    for bank := 0; bank < 64; bank++ {
+        rom[bank * ROMBankSize] = byte(bank)
+    }
+    for i := range Logo {
+        rom[logoAddress + i] = 0x55
+    }
+    for address := uint16(0x0100); address < HeaderEnd; address++ {
+        if address >= logoAddress && address < logoAddress + uint16(len(Logo)) {
+            rom[unscrambleSachen(address | 0x80)] = header[address]
+            continue
+        }
+        rom[unscrambleSachen(address)] = header[address]
+        rom[unscrambleSachen(address | 0x80)] = decoy[address]
+    }
+    return rom
+}
+
+func TestSachenIsDetectedFromScrambledLogo(t *testing.T) {
+
+    tests := []struct {
+        cgb     byte
+        mapper  string
+    }{
+        {0x00, "Sachen MMC1"},
+        {0x80, "Sachen MMC2"},
+    }
+
+    for _, test := range tests {
+
+        // When
+        c, err := New(buildSachen(t, test.cgb))
+        if err != nil {
+            t.Fatal(err)
+        }
+
+        // Then
+        if c.Detection == nil || c.Detection.Mapper != test.mapper {
+            t.Errorf("CGB flag 0x%02X: cartridge should be detected as %s, got %v", test.cgb, test.mapper, c.Detection)
+            continue
+        }
+        if c.Header.Title != "SACHEN" || c.Header.Type.MBC != NoMBC {
+            t.Errorf("CGB flag 0x%02X: header should be the one read without A7, got %q, %s", test.cgb, c.Header.Title, c.Header.Type)
+        }
+    }
+}
+
+func TestSachenScramblesHeaderAddresses(t *testing.T) {
+
+    // Given
+    rom := buildSachen(t, 0x00)
+    rom[0x0103] = 0x42 // Read at 0x0150: A6 to A0, A4 to A1.
+
+    c, err := New(rom)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // When
+    got := c.Read(0x0150)
+
+    // Then
+    if got != 0x42 {
+        t.Error("0x0150 should read ROM at 0x0103, got ", got)
+    }
+    if c.Page(0x01) != nil {
+        t.Error("Page 0x01 should be read through Read")
+    }
+}
+
+func TestSachenSwitchesBanks(t *testing.T) {
+
+    // Given
+    c, err := New(buildSachen(t, 0x00))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // When: base and mask are not writable until bank bits 4-5 are set.
+    c.Write(0x0000, 0x04)
+    c.Write(0x2000, 0x03)
+
+    // Then
+    if got := c.Read(0x0000); got != 0 || c.Read(0x4000) != 3 {
+        t.Error("Banks should be 0 and 3, got ", got, " and ", c.Read(0x4000))
+    }
+
+    // When
+    c.Write(0x2000, 0x30)
+    c.Write(0x0000, 0x04)
+    c.Write(0x4000, 0x0C)
+
+    // Then: masked bits come from the base bank.
+    if got := c.Read(0x0000); got != 0x04 {
+        t.Error("0x0000 should read bank 4, got ", got)
+    }
+    if got := c.Read(0x4000); got != 0x34 {
+        t.Error("0x4000 should read bank 0x34, got ", got)
+    }
+}
+
+func TestWisdomTreeSwitches32KiBBanks(t *testing.T) {
+
+    // Given: 128 KiB with a ROM only header.
+    rom := make([]byte, 8 * ROMBankSize)
+    copy(rom, buildROM(t, 0x00, 0x00, 0x00))
+    for bank := 0; bank < 8; bank++ {
+        rom[bank * ROMBankSize] = byte(bank)
+    }
+    copy(rom[0x0200:], "WISDOM TREE")
+
+    c, err := New(rom)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if c.Detection == nil || c.Detection.Mapper != "Wisdom Tree" {
+        t.Fatal("Cartridge should be detected as Wisdom Tree, got ", c.Detection)
+    }
+
+    // When: the bank is in the address.
+    c.Write(0x0002, 0xFF)
+
+    // Then
+    if got := c.Read(0x0000); got != 4 {
+        t.Error("0x0000 should read bank 4, got ", got)
+    }
+    if got := c.Page(0x40)[0]; got != 5 {
+        t.Error("Page 0x40 should be in bank 5, got ", got)
+    }
+}
+
+func TestBootlegMBC5IsDetected(t *testing.T) {
+
+    tests := []struct {
+        name        string
+        code        byte
+        romSizeCode byte
+        size        int
+    }{
+        {"MBC1 header on 4 MiB", 0x03, 0x07, 0x400000},
+        {"MBC5 header on a larger ROM", 0x1B, 0x05, 0x200000},
+    }
+
+    for _, test := range tests {
+
+        // Given
+        rom := make([]byte, test.size)
+        copy(rom, buildROM(t, test.code, test.romSizeCode, 0x03))
+        for bank := 0; bank < test.size / ROMBankSize; bank++ {
+            rom[bank * ROMBankSize] = byte(bank)
+        }
+
+        // When
+        c, err := New(rom)
+        if err != nil {
+            t.Fatal(err)
+        }
+
+        // Then
+        if c.Detection == nil || c.Detection.Mapper != "bootleg MBC5" {
+            t.Errorf("%s: cartridge should be detected as bootleg MBC5, got %v", test.name, c.Detection)
+            continue
+        }
+        c.Write(0x2000, 0x7F)
+        if got := c.Read(0x4000); got != 0x7F {
+            t.Errorf("%s: 0x4000 should read bank 0x7F, got 0x%02X", test.name, got)
+        }
+    }
+}
+
+func TestLicensedCartridgesAreNotDetected(t *testing.T) {
+
+    // When
+    c := newTestCartridge(t, 0x1B, 0x05, 0x03)
+
+    // Then
+    if c.Detection != nil {
+        t.Error("Cartridge should use the type in its header, got ", c.Detection)
+    }
+}
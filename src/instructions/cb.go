@@ -0,0 +1,32 @@
+package instructions
+
+// CB-prefixed opcodes are encoded as 0bxxyyyzzz.
+// zzz selects the operand: B, C, D, E, H, L, (HL), A.
+// For BIT, RES and SET yyy selects the bit, e.g. SET 3, D = CB_SET | 3 << 3 | CB_D.
+const (
+
+    // Operands
+    CB_B        = 0x00
+    CB_C        = 0x01
+    CB_D        = 0x02
+    CB_E        = 0x03
+    CB_H        = 0x04
+    CB_L        = 0x05
+    CB_indHL    = 0x06
+    CB_A        = 0x07
+
+    // Rotates and shifts
+    CB_RLC      = 0x00
+    CB_RRC      = 0x08
+    CB_RL       = 0x10
+    CB_RR       = 0x18
+    CB_SLA      = 0x20
+    CB_SRA      = 0x28
+    CB_SWAP     = 0x30
+    CB_SRL      = 0x38
+
+    // Single bit operations
+    CB_BIT      = 0x40
+    CB_RES      = 0x80
+    CB_SET      = 0xC0
+)
@@ -0,0 +1,47 @@
+// Gen writes opcodes.go, the named opcode constants, from the opcode metadata tables.
+//
+// Run it with "go generate" from the instructions package.
+package main
+
+import (
+	"bytes"
+	"cgbemu/src/instructions"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+func main() {
+
+    var b bytes.Buffer
+
+    fmt.Fprintln(&b, "// Code generated by go run ./gen; DO NOT EDIT.")
+    fmt.Fprintln(&b)
+    fmt.Fprintln(&b, "package instructions")
+    fmt.Fprintln(&b)
+
+    writeConstants(&b, "Unprefixed opcodes.", instructions.Unprefixed)
+    writeConstants(&b, "CB-prefixed opcodes, following PREFIX_CB.", instructions.CBPrefixed)
+
+    src, err := format.Source(b.Bytes())
+    if err != nil {
+        log.Fatalln("Formatting generated source: ", err)
+    }
+
+    if err := os.WriteFile("opcodes.go", src, 0644); err != nil {
+        log.Fatalln("Writing opcodes.go: ", err)
+    }
+}
+
+// writeConstants writes a const block with one constant per opcode of table.
+func writeConstants(b *bytes.Buffer, doc string, table [256]instructions.Opcode) {
+
+    fmt.Fprintf(b, "// %s\n", doc)
+    fmt.Fprintln(b, "const (")
+    for op, opcode := range table {
+        fmt.Fprintf(b, "\t%s = 0x%02X // %s\n", opcode.Name, op, opcode)
+    }
+    fmt.Fprintln(b, ")")
+    fmt.Fprintln(b)
+}
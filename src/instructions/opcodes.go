@@ -1,13 +1,523 @@
+// Code generated by go run ./gen; DO NOT EDIT.
+
 package instructions
 
-// Opcodes
+// Unprefixed opcodes.
 const (
-    
-    // Load Instructions
-    // 8-bit instructions
-    LDB_IM = 0x06
-    LDB_HL = 0x46
+	NOP        = 0x00 // NOP
+	LDBC_d16   = 0x01 // LD BC, n16
+	LDBC_A     = 0x02 // LD (BC), A
+	INC_BC     = 0x03 // INC BC
+	INC_B      = 0x04 // INC B
+	DEC_B      = 0x05 // DEC B
+	LDB_IM     = 0x06 // LD B, n8
+	RLCA       = 0x07 // RLCA
+	LDa16_SP   = 0x08 // LD (a16), SP
+	ADDHL_BC   = 0x09 // ADD HL, BC
+	LDA_BC     = 0x0A // LD A, (BC)
+	DEC_BC     = 0x0B // DEC BC
+	INC_C      = 0x0C // INC C
+	DEC_C      = 0x0D // DEC C
+	LDC_d8     = 0x0E // LD C, n8
+	RRCA       = 0x0F // RRCA
+	STOP       = 0x10 // STOP n8
+	LDDE_d16   = 0x11 // LD DE, n16
+	LDDE_A     = 0x12 // LD (DE), A
+	INC_DE     = 0x13 // INC DE
+	INC_D      = 0x14 // INC D
+	DEC_D      = 0x15 // DEC D
+	LDD_d8     = 0x16 // LD D, n8
+	RLA        = 0x17 // RLA
+	JR_e       = 0x18 // JR e8
+	ADDHL_DE   = 0x19 // ADD HL, DE
+	LDA_DE     = 0x1A // LD A, (DE)
+	DEC_DE     = 0x1B // DEC DE
+	INC_E      = 0x1C // INC E
+	DEC_E      = 0x1D // DEC E
+	LDE_d8     = 0x1E // LD E, n8
+	RRA        = 0x1F // RRA
+	JRNZ_e     = 0x20 // JR NZ, e8
+	LDHL_d16   = 0x21 // LD HL, n16
+	LDHLinc_A  = 0x22 // LD (HL+), A
+	INC_HL     = 0x23 // INC HL
+	INC_H      = 0x24 // INC H
+	DEC_H      = 0x25 // DEC H
+	LDH_d8     = 0x26 // LD H, n8
+	DAA        = 0x27 // DAA
+	JRZ_e      = 0x28 // JR Z, e8
+	ADDHL_HL   = 0x29 // ADD HL, HL
+	LDA_HLinc  = 0x2A // LD A, (HL+)
+	DEC_HL     = 0x2B // DEC HL
+	INC_L      = 0x2C // INC L
+	DEC_L      = 0x2D // DEC L
+	LDL_d8     = 0x2E // LD L, n8
+	CPL        = 0x2F // CPL
+	JRNC_e     = 0x30 // JR NC, e8
+	LDSP_d16   = 0x31 // LD SP, n16
+	LDHLdec_A  = 0x32 // LD (HL-), A
+	INC_SP     = 0x33 // INC SP
+	INC_indHL  = 0x34 // INC (HL)
+	DEC_indHL  = 0x35 // DEC (HL)
+	LDHL_d8    = 0x36 // LD (HL), n8
+	SCF        = 0x37 // SCF
+	JRC_e      = 0x38 // JR C, e8
+	ADDHL_SP   = 0x39 // ADD HL, SP
+	LDA_HLdec  = 0x3A // LD A, (HL-)
+	DEC_SP     = 0x3B // DEC SP
+	INC_A      = 0x3C // INC A
+	DEC_A      = 0x3D // DEC A
+	LDA_d8     = 0x3E // LD A, n8
+	CCF        = 0x3F // CCF
+	LDB_B      = 0x40 // LD B, B
+	LDB_C      = 0x41 // LD B, C
+	LDB_D      = 0x42 // LD B, D
+	LDB_E      = 0x43 // LD B, E
+	LDB_H      = 0x44 // LD B, H
+	LDB_L      = 0x45 // LD B, L
+	LDB_HL     = 0x46 // LD B, (HL)
+	LDB_A      = 0x47 // LD B, A
+	LDC_B      = 0x48 // LD C, B
+	LDC_C      = 0x49 // LD C, C
+	LDC_D      = 0x4A // LD C, D
+	LDC_E      = 0x4B // LD C, E
+	LDC_H      = 0x4C // LD C, H
+	LDC_L      = 0x4D // LD C, L
+	LDC_HL     = 0x4E // LD C, (HL)
+	LDC_A      = 0x4F // LD C, A
+	LDD_B      = 0x50 // LD D, B
+	LDD_C      = 0x51 // LD D, C
+	LDD_D      = 0x52 // LD D, D
+	LDD_E      = 0x53 // LD D, E
+	LDD_H      = 0x54 // LD D, H
+	LDD_L      = 0x55 // LD D, L
+	LDD_HL     = 0x56 // LD D, (HL)
+	LDD_A      = 0x57 // LD D, A
+	LDE_B      = 0x58 // LD E, B
+	LDE_C      = 0x59 // LD E, C
+	LDE_D      = 0x5A // LD E, D
+	LDE_E      = 0x5B // LD E, E
+	LDE_H      = 0x5C // LD E, H
+	LDE_L      = 0x5D // LD E, L
+	LDE_HL     = 0x5E // LD E, (HL)
+	LDE_A      = 0x5F // LD E, A
+	LDH_B      = 0x60 // LD H, B
+	LDH_C      = 0x61 // LD H, C
+	LDH_D      = 0x62 // LD H, D
+	LDH_E      = 0x63 // LD H, E
+	LDH_H      = 0x64 // LD H, H
+	LDH_L      = 0x65 // LD H, L
+	LDH_HL     = 0x66 // LD H, (HL)
+	LDH_A      = 0x67 // LD H, A
+	LDL_B      = 0x68 // LD L, B
+	LDL_C      = 0x69 // LD L, C
+	LDL_D      = 0x6A // LD L, D
+	LDL_E      = 0x6B // LD L, E
+	LDL_H      = 0x6C // LD L, H
+	LDL_L      = 0x6D // LD L, L
+	LDL_HL     = 0x6E // LD L, (HL)
+	LDL_A      = 0x6F // LD L, A
+	LDHL_B     = 0x70 // LD (HL), B
+	LDHL_C     = 0x71 // LD (HL), C
+	LDHL_D     = 0x72 // LD (HL), D
+	LDHL_E     = 0x73 // LD (HL), E
+	LDHL_H     = 0x74 // LD (HL), H
+	LDHL_L     = 0x75 // LD (HL), L
+	HALT       = 0x76 // HALT
+	LDHL_A     = 0x77 // LD (HL), A
+	LDA_B      = 0x78 // LD A, B
+	LDA_C      = 0x79 // LD A, C
+	LDA_D      = 0x7A // LD A, D
+	LDA_E      = 0x7B // LD A, E
+	LDA_H      = 0x7C // LD A, H
+	LDA_L      = 0x7D // LD A, L
+	LDA_HL     = 0x7E // LD A, (HL)
+	LDA_A      = 0x7F // LD A, A
+	ADD_B      = 0x80 // ADD A, B
+	ADD_C      = 0x81 // ADD A, C
+	ADD_D      = 0x82 // ADD A, D
+	ADD_E      = 0x83 // ADD A, E
+	ADD_H      = 0x84 // ADD A, H
+	ADD_L      = 0x85 // ADD A, L
+	ADD_indHL  = 0x86 // ADD A, (HL)
+	ADD_A      = 0x87 // ADD A, A
+	ADC_B      = 0x88 // ADC A, B
+	ADC_C      = 0x89 // ADC A, C
+	ADC_D      = 0x8A // ADC A, D
+	ADC_E      = 0x8B // ADC A, E
+	ADC_H      = 0x8C // ADC A, H
+	ADC_L      = 0x8D // ADC A, L
+	ADC_indHL  = 0x8E // ADC A, (HL)
+	ADC_A      = 0x8F // ADC A, A
+	SUB_B      = 0x90 // SUB A, B
+	SUB_C      = 0x91 // SUB A, C
+	SUB_D      = 0x92 // SUB A, D
+	SUB_E      = 0x93 // SUB A, E
+	SUB_H      = 0x94 // SUB A, H
+	SUB_L      = 0x95 // SUB A, L
+	SUB_indHL  = 0x96 // SUB A, (HL)
+	SUB_A      = 0x97 // SUB A, A
+	SBC_B      = 0x98 // SBC A, B
+	SBC_C      = 0x99 // SBC A, C
+	SBC_D      = 0x9A // SBC A, D
+	SBC_E      = 0x9B // SBC A, E
+	SBC_H      = 0x9C // SBC A, H
+	SBC_L      = 0x9D // SBC A, L
+	SBC_indHL  = 0x9E // SBC A, (HL)
+	SBC_A      = 0x9F // SBC A, A
+	AND_B      = 0xA0 // AND A, B
+	AND_C      = 0xA1 // AND A, C
+	AND_D      = 0xA2 // AND A, D
+	AND_E      = 0xA3 // AND A, E
+	AND_H      = 0xA4 // AND A, H
+	AND_L      = 0xA5 // AND A, L
+	AND_indHL  = 0xA6 // AND A, (HL)
+	AND_A      = 0xA7 // AND A, A
+	XOR_B      = 0xA8 // XOR A, B
+	XOR_C      = 0xA9 // XOR A, C
+	XOR_D      = 0xAA // XOR A, D
+	XOR_E      = 0xAB // XOR A, E
+	XOR_H      = 0xAC // XOR A, H
+	XOR_L      = 0xAD // XOR A, L
+	XOR_indHL  = 0xAE // XOR A, (HL)
+	XOR_A      = 0xAF // XOR A, A
+	OR_B       = 0xB0 // OR A, B
+	OR_C       = 0xB1 // OR A, C
+	OR_D       = 0xB2 // OR A, D
+	OR_E       = 0xB3 // OR A, E
+	OR_H       = 0xB4 // OR A, H
+	OR_L       = 0xB5 // OR A, L
+	OR_indHL   = 0xB6 // OR A, (HL)
+	OR_A       = 0xB7 // OR A, A
+	CP_B       = 0xB8 // CP A, B
+	CP_C       = 0xB9 // CP A, C
+	CP_D       = 0xBA // CP A, D
+	CP_E       = 0xBB // CP A, E
+	CP_H       = 0xBC // CP A, H
+	CP_L       = 0xBD // CP A, L
+	CP_indHL   = 0xBE // CP A, (HL)
+	CP_A       = 0xBF // CP A, A
+	RETNZ      = 0xC0 // RET NZ
+	POP_BC     = 0xC1 // POP BC
+	JPNZ_a16   = 0xC2 // JP NZ, a16
+	JP_a16     = 0xC3 // JP a16
+	CALLNZ_a16 = 0xC4 // CALL NZ, a16
+	PUSH_BC    = 0xC5 // PUSH BC
+	ADD_d8     = 0xC6 // ADD A, n8
+	RST_00     = 0xC7 // RST 00H
+	RETZ       = 0xC8 // RET Z
+	RET        = 0xC9 // RET
+	JPZ_a16    = 0xCA // JP Z, a16
+	PREFIX_CB  = 0xCB // PREFIX
+	CALLZ_a16  = 0xCC // CALL Z, a16
+	CALL_a16   = 0xCD // CALL a16
+	ADC_d8     = 0xCE // ADC A, n8
+	RST_08     = 0xCF // RST 08H
+	RETNC      = 0xD0 // RET NC
+	POP_DE     = 0xD1 // POP DE
+	JPNC_a16   = 0xD2 // JP NC, a16
+	ILLEGAL_D3 = 0xD3 // ILLEGAL
+	CALLNC_a16 = 0xD4 // CALL NC, a16
+	PUSH_DE    = 0xD5 // PUSH DE
+	SUB_d8     = 0xD6 // SUB A, n8
+	RST_10     = 0xD7 // RST 10H
+	RETC       = 0xD8 // RET C
+	RETI       = 0xD9 // RETI
+	JPC_a16    = 0xDA // JP C, a16
+	ILLEGAL_DB = 0xDB // ILLEGAL
+	CALLC_a16  = 0xDC // CALL C, a16
+	ILLEGAL_DD = 0xDD // ILLEGAL
+	SBC_d8     = 0xDE // SBC A, n8
+	RST_18     = 0xDF // RST 18H
+	LDa8_A     = 0xE0 // LDH (a8), A
+	POP_HL     = 0xE1 // POP HL
+	LDCind_A   = 0xE2 // LD (C), A
+	ILLEGAL_E3 = 0xE3 // ILLEGAL
+	ILLEGAL_E4 = 0xE4 // ILLEGAL
+	PUSH_HL    = 0xE5 // PUSH HL
+	AND_d8     = 0xE6 // AND A, n8
+	RST_20     = 0xE7 // RST 20H
+	ADDSP_e    = 0xE8 // ADD SP, e8
+	JP_HL      = 0xE9 // JP HL
+	LDa16_A    = 0xEA // LD (a16), A
+	ILLEGAL_EB = 0xEB // ILLEGAL
+	ILLEGAL_EC = 0xEC // ILLEGAL
+	ILLEGAL_ED = 0xED // ILLEGAL
+	XOR_d8     = 0xEE // XOR A, n8
+	RST_28     = 0xEF // RST 28H
+	LDA_a8     = 0xF0 // LDH A, (a8)
+	POP_AF     = 0xF1 // POP AF
+	LDA_Cind   = 0xF2 // LD A, (C)
+	DI         = 0xF3 // DI
+	ILLEGAL_F4 = 0xF4 // ILLEGAL
+	PUSH_AF    = 0xF5 // PUSH AF
+	OR_d8      = 0xF6 // OR A, n8
+	RST_30     = 0xF7 // RST 30H
+	LDHL_SPs8  = 0xF8 // LD HL, SP+e8
+	LDSP_HL    = 0xF9 // LD SP, HL
+	LDA_a16    = 0xFA // LD A, (a16)
+	EI         = 0xFB // EI
+	ILLEGAL_FC = 0xFC // ILLEGAL
+	ILLEGAL_FD = 0xFD // ILLEGAL
+	CP_d8      = 0xFE // CP A, n8
+	RST_38     = 0xFF // RST 38H
+)
 
-    // 16-bit instructions
-    LDBC_d16 = 0x01
+// CB-prefixed opcodes, following PREFIX_CB.
+const (
+	RLC_B      = 0x00 // RLC B
+	RLC_C      = 0x01 // RLC C
+	RLC_D      = 0x02 // RLC D
+	RLC_E      = 0x03 // RLC E
+	RLC_H      = 0x04 // RLC H
+	RLC_L      = 0x05 // RLC L
+	RLC_indHL  = 0x06 // RLC (HL)
+	RLC_A      = 0x07 // RLC A
+	RRC_B      = 0x08 // RRC B
+	RRC_C      = 0x09 // RRC C
+	RRC_D      = 0x0A // RRC D
+	RRC_E      = 0x0B // RRC E
+	RRC_H      = 0x0C // RRC H
+	RRC_L      = 0x0D // RRC L
+	RRC_indHL  = 0x0E // RRC (HL)
+	RRC_A      = 0x0F // RRC A
+	RL_B       = 0x10 // RL B
+	RL_C       = 0x11 // RL C
+	RL_D       = 0x12 // RL D
+	RL_E       = 0x13 // RL E
+	RL_H       = 0x14 // RL H
+	RL_L       = 0x15 // RL L
+	RL_indHL   = 0x16 // RL (HL)
+	RL_A       = 0x17 // RL A
+	RR_B       = 0x18 // RR B
+	RR_C       = 0x19 // RR C
+	RR_D       = 0x1A // RR D
+	RR_E       = 0x1B // RR E
+	RR_H       = 0x1C // RR H
+	RR_L       = 0x1D // RR L
+	RR_indHL   = 0x1E // RR (HL)
+	RR_A       = 0x1F // RR A
+	SLA_B      = 0x20 // SLA B
+	SLA_C      = 0x21 // SLA C
+	SLA_D      = 0x22 // SLA D
+	SLA_E      = 0x23 // SLA E
+	SLA_H      = 0x24 // SLA H
+	SLA_L      = 0x25 // SLA L
+	SLA_indHL  = 0x26 // SLA (HL)
+	SLA_A      = 0x27 // SLA A
+	SRA_B      = 0x28 // SRA B
+	SRA_C      = 0x29 // SRA C
+	SRA_D      = 0x2A // SRA D
+	SRA_E      = 0x2B // SRA E
+	SRA_H      = 0x2C // SRA H
+	SRA_L      = 0x2D // SRA L
+	SRA_indHL  = 0x2E // SRA (HL)
+	SRA_A      = 0x2F // SRA A
+	SWAP_B     = 0x30 // SWAP B
+	SWAP_C     = 0x31 // SWAP C
+	SWAP_D     = 0x32 // SWAP D
+	SWAP_E     = 0x33 // SWAP E
+	SWAP_H     = 0x34 // SWAP H
+	SWAP_L     = 0x35 // SWAP L
+	SWAP_indHL = 0x36 // SWAP (HL)
+	SWAP_A     = 0x37 // SWAP A
+	SRL_B      = 0x38 // SRL B
+	SRL_C      = 0x39 // SRL C
+	SRL_D      = 0x3A // SRL D
+	SRL_E      = 0x3B // SRL E
+	SRL_H      = 0x3C // SRL H
+	SRL_L      = 0x3D // SRL L
+	SRL_indHL  = 0x3E // SRL (HL)
+	SRL_A      = 0x3F // SRL A
+	BIT0_B     = 0x40 // BIT 0, B
+	BIT0_C     = 0x41 // BIT 0, C
+	BIT0_D     = 0x42 // BIT 0, D
+	BIT0_E     = 0x43 // BIT 0, E
+	BIT0_H     = 0x44 // BIT 0, H
+	BIT0_L     = 0x45 // BIT 0, L
+	BIT0_indHL = 0x46 // BIT 0, (HL)
+	BIT0_A     = 0x47 // BIT 0, A
+	BIT1_B     = 0x48 // BIT 1, B
+	BIT1_C     = 0x49 // BIT 1, C
+	BIT1_D     = 0x4A // BIT 1, D
+	BIT1_E     = 0x4B // BIT 1, E
+	BIT1_H     = 0x4C // BIT 1, H
+	BIT1_L     = 0x4D // BIT 1, L
+	BIT1_indHL = 0x4E // BIT 1, (HL)
+	BIT1_A     = 0x4F // BIT 1, A
+	BIT2_B     = 0x50 // BIT 2, B
+	BIT2_C     = 0x51 // BIT 2, C
+	BIT2_D     = 0x52 // BIT 2, D
+	BIT2_E     = 0x53 // BIT 2, E
+	BIT2_H     = 0x54 // BIT 2, H
+	BIT2_L     = 0x55 // BIT 2, L
+	BIT2_indHL = 0x56 // BIT 2, (HL)
+	BIT2_A     = 0x57 // BIT 2, A
+	BIT3_B     = 0x58 // BIT 3, B
+	BIT3_C     = 0x59 // BIT 3, C
+	BIT3_D     = 0x5A // BIT 3, D
+	BIT3_E     = 0x5B // BIT 3, E
+	BIT3_H     = 0x5C // BIT 3, H
+	BIT3_L     = 0x5D // BIT 3, L
+	BIT3_indHL = 0x5E // BIT 3, (HL)
+	BIT3_A     = 0x5F // BIT 3, A
+	BIT4_B     = 0x60 // BIT 4, B
+	BIT4_C     = 0x61 // BIT 4, C
+	BIT4_D     = 0x62 // BIT 4, D
+	BIT4_E     = 0x63 // BIT 4, E
+	BIT4_H     = 0x64 // BIT 4, H
+	BIT4_L     = 0x65 // BIT 4, L
+	BIT4_indHL = 0x66 // BIT 4, (HL)
+	BIT4_A     = 0x67 // BIT 4, A
+	BIT5_B     = 0x68 // BIT 5, B
+	BIT5_C     = 0x69 // BIT 5, C
+	BIT5_D     = 0x6A // BIT 5, D
+	BIT5_E     = 0x6B // BIT 5, E
+	BIT5_H     = 0x6C // BIT 5, H
+	BIT5_L     = 0x6D // BIT 5, L
+	BIT5_indHL = 0x6E // BIT 5, (HL)
+	BIT5_A     = 0x6F // BIT 5, A
+	BIT6_B     = 0x70 // BIT 6, B
+	BIT6_C     = 0x71 // BIT 6, C
+	BIT6_D     = 0x72 // BIT 6, D
+	BIT6_E     = 0x73 // BIT 6, E
+	BIT6_H     = 0x74 // BIT 6, H
+	BIT6_L     = 0x75 // BIT 6, L
+	BIT6_indHL = 0x76 // BIT 6, (HL)
+	BIT6_A     = 0x77 // BIT 6, A
+	BIT7_B     = 0x78 // BIT 7, B
+	BIT7_C     = 0x79 // BIT 7, C
+	BIT7_D     = 0x7A // BIT 7, D
+	BIT7_E     = 0x7B // BIT 7, E
+	BIT7_H     = 0x7C // BIT 7, H
+	BIT7_L     = 0x7D // BIT 7, L
+	BIT7_indHL = 0x7E // BIT 7, (HL)
+	BIT7_A     = 0x7F // BIT 7, A
+	RES0_B     = 0x80 // RES 0, B
+	RES0_C     = 0x81 // RES 0, C
+	RES0_D     = 0x82 // RES 0, D
+	RES0_E     = 0x83 // RES 0, E
+	RES0_H     = 0x84 // RES 0, H
+	RES0_L     = 0x85 // RES 0, L
+	RES0_indHL = 0x86 // RES 0, (HL)
+	RES0_A     = 0x87 // RES 0, A
+	RES1_B     = 0x88 // RES 1, B
+	RES1_C     = 0x89 // RES 1, C
+	RES1_D     = 0x8A // RES 1, D
+	RES1_E     = 0x8B // RES 1, E
+	RES1_H     = 0x8C // RES 1, H
+	RES1_L     = 0x8D // RES 1, L
+	RES1_indHL = 0x8E // RES 1, (HL)
+	RES1_A     = 0x8F // RES 1, A
+	RES2_B     = 0x90 // RES 2, B
+	RES2_C     = 0x91 // RES 2, C
+	RES2_D     = 0x92 // RES 2, D
+	RES2_E     = 0x93 // RES 2, E
+	RES2_H     = 0x94 // RES 2, H
+	RES2_L     = 0x95 // RES 2, L
+	RES2_indHL = 0x96 // RES 2, (HL)
+	RES2_A     = 0x97 // RES 2, A
+	RES3_B     = 0x98 // RES 3, B
+	RES3_C     = 0x99 // RES 3, C
+	RES3_D     = 0x9A // RES 3, D
+	RES3_E     = 0x9B // RES 3, E
+	RES3_H     = 0x9C // RES 3, H
+	RES3_L     = 0x9D // RES 3, L
+	RES3_indHL = 0x9E // RES 3, (HL)
+	RES3_A     = 0x9F // RES 3, A
+	RES4_B     = 0xA0 // RES 4, B
+	RES4_C     = 0xA1 // RES 4, C
+	RES4_D     = 0xA2 // RES 4, D
+	RES4_E     = 0xA3 // RES 4, E
+	RES4_H     = 0xA4 // RES 4, H
+	RES4_L     = 0xA5 // RES 4, L
+	RES4_indHL = 0xA6 // RES 4, (HL)
+	RES4_A     = 0xA7 // RES 4, A
+	RES5_B     = 0xA8 // RES 5, B
+	RES5_C     = 0xA9 // RES 5, C
+	RES5_D     = 0xAA // RES 5, D
+	RES5_E     = 0xAB // RES 5, E
+	RES5_H     = 0xAC // RES 5, H
+	RES5_L     = 0xAD // RES 5, L
+	RES5_indHL = 0xAE // RES 5, (HL)
+	RES5_A     = 0xAF // RES 5, A
+	RES6_B     = 0xB0 // RES 6, B
+	RES6_C     = 0xB1 // RES 6, C
+	RES6_D     = 0xB2 // RES 6, D
+	RES6_E     = 0xB3 // RES 6, E
+	RES6_H     = 0xB4 // RES 6, H
+	RES6_L     = 0xB5 // RES 6, L
+	RES6_indHL = 0xB6 // RES 6, (HL)
+	RES6_A     = 0xB7 // RES 6, A
+	RES7_B     = 0xB8 // RES 7, B
+	RES7_C     = 0xB9 // RES 7, C
+	RES7_D     = 0xBA // RES 7, D
+	RES7_E     = 0xBB // RES 7, E
+	RES7_H     = 0xBC // RES 7, H
+	RES7_L     = 0xBD // RES 7, L
+	RES7_indHL = 0xBE // RES 7, (HL)
+	RES7_A     = 0xBF // RES 7, A
+	SET0_B     = 0xC0 // SET 0, B
+	SET0_C     = 0xC1 // SET 0, C
+	SET0_D     = 0xC2 // SET 0, D
+	SET0_E     = 0xC3 // SET 0, E
+	SET0_H     = 0xC4 // SET 0, H
+	SET0_L     = 0xC5 // SET 0, L
+	SET0_indHL = 0xC6 // SET 0, (HL)
+	SET0_A     = 0xC7 // SET 0, A
+	SET1_B     = 0xC8 // SET 1, B
+	SET1_C     = 0xC9 // SET 1, C
+	SET1_D     = 0xCA // SET 1, D
+	SET1_E     = 0xCB // SET 1, E
+	SET1_H     = 0xCC // SET 1, H
+	SET1_L     = 0xCD // SET 1, L
+	SET1_indHL = 0xCE // SET 1, (HL)
+	SET1_A     = 0xCF // SET 1, A
+	SET2_B     = 0xD0 // SET 2, B
+	SET2_C     = 0xD1 // SET 2, C
+	SET2_D     = 0xD2 // SET 2, D
+	SET2_E     = 0xD3 // SET 2, E
+	SET2_H     = 0xD4 // SET 2, H
+	SET2_L     = 0xD5 // SET 2, L
+	SET2_indHL = 0xD6 // SET 2, (HL)
+	SET2_A     = 0xD7 // SET 2, A
+	SET3_B     = 0xD8 // SET 3, B
+	SET3_C     = 0xD9 // SET 3, C
+	SET3_D     = 0xDA // SET 3, D
+	SET3_E     = 0xDB // SET 3, E
+	SET3_H     = 0xDC // SET 3, H
+	SET3_L     = 0xDD // SET 3, L
+	SET3_indHL = 0xDE // SET 3, (HL)
+	SET3_A     = 0xDF // SET 3, A
+	SET4_B     = 0xE0 // SET 4, B
+	SET4_C     = 0xE1 // SET 4, C
+	SET4_D     = 0xE2 // SET 4, D
+	SET4_E     = 0xE3 // SET 4, E
+	SET4_H     = 0xE4 // SET 4, H
+	SET4_L     = 0xE5 // SET 4, L
+	SET4_indHL = 0xE6 // SET 4, (HL)
+	SET4_A     = 0xE7 // SET 4, A
+	SET5_B     = 0xE8 // SET 5, B
+	SET5_C     = 0xE9 // SET 5, C
+	SET5_D     = 0xEA // SET 5, D
+	SET5_E     = 0xEB // SET 5, E
+	SET5_H     = 0xEC // SET 5, H
+	SET5_L     = 0xED // SET 5, L
+	SET5_indHL = 0xEE // SET 5, (HL)
+	SET5_A     = 0xEF // SET 5, A
+	SET6_B     = 0xF0 // SET 6, B
+	SET6_C     = 0xF1 // SET 6, C
+	SET6_D     = 0xF2 // SET 6, D
+	SET6_E     = 0xF3 // SET 6, E
+	SET6_H     = 0xF4 // SET 6, H
+	SET6_L     = 0xF5 // SET 6, L
+	SET6_indHL = 0xF6 // SET 6, (HL)
+	SET6_A     = 0xF7 // SET 6, A
+	SET7_B     = 0xF8 // SET 7, B
+	SET7_C     = 0xF9 // SET 7, C
+	SET7_D     = 0xFA // SET 7, D
+	SET7_E     = 0xFB // SET 7, E
+	SET7_H     = 0xFC // SET 7, H
+	SET7_L     = 0xFD // SET 7, L
+	SET7_indHL = 0xFE // SET 7, (HL)
+	SET7_A     = 0xFF // SET 7, A
 )
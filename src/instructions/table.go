@@ -0,0 +1,624 @@
+package instructions
+
+import "strings"
+
+//go:generate go run ./gen
+
+// Opcode metadata for the whole SM83 instruction set, following:
+//
+// https://gekkio.fi/files/gb-docs/gbctr.pdf
+// https://meganesu.github.io/generate-gb-opcodes/
+//
+// Unprefixed and CBPrefixed are the single source of truth: the named constants in
+// opcodes.go are generated from them, run "go generate" after editing a table.
+
+// OperandKind tells how an operand is encoded.
+type OperandKind byte
+
+const (
+    Reg8        OperandKind = iota // 8-bit register: A, B, C, D, E, H, L.
+    Reg16                          // 16-bit register pair: AF, BC, DE, HL, SP.
+    IndReg                         // Memory at the address in a register: (BC), (DE), (HL), (HL+), (HL-), (C).
+    Imm8                           // 8-bit immediate data n8.
+    Imm16                          // 16-bit immediate data n16, little-endian.
+    Offset8                        // Signed 8-bit immediate offset e8.
+    SPOffset8                      // SP plus the signed 8-bit immediate offset e8.
+    Addr8                          // Memory at 0xFF00 + 8-bit immediate address a8.
+    Addr16                         // 16-bit immediate address a16, little-endian.
+    Cond                           // Condition on flags: NZ, Z, NC, C.
+    BitIndex                       // Bit number 0-7 of BIT, RES and SET.
+    Vector                         // Fixed RST target address.
+)
+
+// Operand is an operand of an instruction, Name is how it is written in the mnemonic.
+type Operand struct {
+    Kind    OperandKind
+    Name    string
+}
+
+// FlagEffect tells how an instruction changes a flag.
+type FlagEffect byte
+
+const (
+    Unaffected  FlagEffect = iota   // Left unchanged: -
+    Reset                           // Always cleared: 0
+    Set                             // Always set: 1
+    Affected                        // Set according to the result.
+)
+
+// Flags holds the effect on Z, N, H and C, in this order.
+type Flags [4]FlagEffect
+
+// String returns the flags in the usual Z N H C notation, e.g. "Z0H-".
+func (f Flags) String() string {
+    symbols := [4]byte{'Z', 'N', 'H', 'C'}
+    s := make([]byte, 4)
+    for i, effect := range f {
+        switch effect {
+        case Unaffected:
+            s[i] = '-'
+        case Reset:
+            s[i] = '0'
+        case Set:
+            s[i] = '1'
+        default:
+            s[i] = symbols[i]
+        }
+    }
+    return string(s)
+}
+
+// Opcode describes an instruction.
+//
+// Length and Cycles of CB-prefixed instructions include the 0xCB prefix.
+// Cycles are machine cycles. For conditional instructions Cycles is the
+// branch-not-taken duration, CyclesTaken the branch-taken one; they are equal otherwise.
+type Opcode struct {
+    Name        string      // Name of the generated constant.
+    Mnemonic    string
+    Operands    []Operand
+    Length      int
+    Cycles      int
+    CyclesTaken int
+    Flags       Flags
+    Illegal     bool        // Not a valid instruction, hard-locks the CPU.
+}
+
+// String returns the instruction in assembly notation, e.g. "LD B, n8".
+func (o Opcode) String() string {
+    if len(o.Operands) == 0 {
+        return o.Mnemonic
+    }
+
+    names := make([]string, len(o.Operands))
+    for i, operand := range o.Operands {
+        names[i] = operand.Name
+    }
+
+    return o.Mnemonic + " " + strings.Join(names, ", ")
+}
+
+// IsConditional reports whether the instruction duration depends on a condition.
+func (o Opcode) IsConditional() bool {
+    return o.CyclesTaken != o.Cycles
+}
+
+// Unprefixed holds the 256 base opcodes.
+var Unprefixed = [256]Opcode{
+    0x00: {Name: "NOP", Mnemonic: "NOP", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x01: {Name: "LDBC_d16", Mnemonic: "LD", Operands: []Operand{{Reg16, "BC"}, {Imm16, "n16"}}, Length: 3, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x02: {Name: "LDBC_A", Mnemonic: "LD", Operands: []Operand{{IndReg, "(BC)"}, {Reg8, "A"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x03: {Name: "INC_BC", Mnemonic: "INC", Operands: []Operand{{Reg16, "BC"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x04: {Name: "INC_B", Mnemonic: "INC", Operands: []Operand{{Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Unaffected}},
+    0x05: {Name: "DEC_B", Mnemonic: "DEC", Operands: []Operand{{Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Unaffected}},
+    0x06: {Name: "LDB_IM", Mnemonic: "LD", Operands: []Operand{{Reg8, "B"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x07: {Name: "RLCA", Mnemonic: "RLCA", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Reset, Reset, Reset, Affected}},
+    0x08: {Name: "LDa16_SP", Mnemonic: "LD", Operands: []Operand{{Addr16, "(a16)"}, {Reg16, "SP"}}, Length: 3, Cycles: 5, CyclesTaken: 5, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x09: {Name: "ADDHL_BC", Mnemonic: "ADD", Operands: []Operand{{Reg16, "HL"}, {Reg16, "BC"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Reset, Affected, Affected}},
+    0x0A: {Name: "LDA_BC", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {IndReg, "(BC)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x0B: {Name: "DEC_BC", Mnemonic: "DEC", Operands: []Operand{{Reg16, "BC"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x0C: {Name: "INC_C", Mnemonic: "INC", Operands: []Operand{{Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Unaffected}},
+    0x0D: {Name: "DEC_C", Mnemonic: "DEC", Operands: []Operand{{Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Unaffected}},
+    0x0E: {Name: "LDC_d8", Mnemonic: "LD", Operands: []Operand{{Reg8, "C"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x0F: {Name: "RRCA", Mnemonic: "RRCA", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Reset, Reset, Reset, Affected}},
+    0x10: {Name: "STOP", Mnemonic: "STOP", Operands: []Operand{{Imm8, "n8"}}, Length: 2, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x11: {Name: "LDDE_d16", Mnemonic: "LD", Operands: []Operand{{Reg16, "DE"}, {Imm16, "n16"}}, Length: 3, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x12: {Name: "LDDE_A", Mnemonic: "LD", Operands: []Operand{{IndReg, "(DE)"}, {Reg8, "A"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x13: {Name: "INC_DE", Mnemonic: "INC", Operands: []Operand{{Reg16, "DE"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x14: {Name: "INC_D", Mnemonic: "INC", Operands: []Operand{{Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Unaffected}},
+    0x15: {Name: "DEC_D", Mnemonic: "DEC", Operands: []Operand{{Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Unaffected}},
+    0x16: {Name: "LDD_d8", Mnemonic: "LD", Operands: []Operand{{Reg8, "D"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x17: {Name: "RLA", Mnemonic: "RLA", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Reset, Reset, Reset, Affected}},
+    0x18: {Name: "JR_e", Mnemonic: "JR", Operands: []Operand{{Offset8, "e8"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x19: {Name: "ADDHL_DE", Mnemonic: "ADD", Operands: []Operand{{Reg16, "HL"}, {Reg16, "DE"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Reset, Affected, Affected}},
+    0x1A: {Name: "LDA_DE", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {IndReg, "(DE)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x1B: {Name: "DEC_DE", Mnemonic: "DEC", Operands: []Operand{{Reg16, "DE"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x1C: {Name: "INC_E", Mnemonic: "INC", Operands: []Operand{{Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Unaffected}},
+    0x1D: {Name: "DEC_E", Mnemonic: "DEC", Operands: []Operand{{Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Unaffected}},
+    0x1E: {Name: "LDE_d8", Mnemonic: "LD", Operands: []Operand{{Reg8, "E"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x1F: {Name: "RRA", Mnemonic: "RRA", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Reset, Reset, Reset, Affected}},
+    0x20: {Name: "JRNZ_e", Mnemonic: "JR", Operands: []Operand{{Cond, "NZ"}, {Offset8, "e8"}}, Length: 2, Cycles: 2, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x21: {Name: "LDHL_d16", Mnemonic: "LD", Operands: []Operand{{Reg16, "HL"}, {Imm16, "n16"}}, Length: 3, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x22: {Name: "LDHLinc_A", Mnemonic: "LD", Operands: []Operand{{IndReg, "(HL+)"}, {Reg8, "A"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x23: {Name: "INC_HL", Mnemonic: "INC", Operands: []Operand{{Reg16, "HL"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x24: {Name: "INC_H", Mnemonic: "INC", Operands: []Operand{{Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Unaffected}},
+    0x25: {Name: "DEC_H", Mnemonic: "DEC", Operands: []Operand{{Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Unaffected}},
+    0x26: {Name: "LDH_d8", Mnemonic: "LD", Operands: []Operand{{Reg8, "H"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x27: {Name: "DAA", Mnemonic: "DAA", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Unaffected, Reset, Affected}},
+    0x28: {Name: "JRZ_e", Mnemonic: "JR", Operands: []Operand{{Cond, "Z"}, {Offset8, "e8"}}, Length: 2, Cycles: 2, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x29: {Name: "ADDHL_HL", Mnemonic: "ADD", Operands: []Operand{{Reg16, "HL"}, {Reg16, "HL"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Reset, Affected, Affected}},
+    0x2A: {Name: "LDA_HLinc", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL+)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x2B: {Name: "DEC_HL", Mnemonic: "DEC", Operands: []Operand{{Reg16, "HL"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x2C: {Name: "INC_L", Mnemonic: "INC", Operands: []Operand{{Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Unaffected}},
+    0x2D: {Name: "DEC_L", Mnemonic: "DEC", Operands: []Operand{{Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Unaffected}},
+    0x2E: {Name: "LDL_d8", Mnemonic: "LD", Operands: []Operand{{Reg8, "L"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x2F: {Name: "CPL", Mnemonic: "CPL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Set, Set, Unaffected}},
+    0x30: {Name: "JRNC_e", Mnemonic: "JR", Operands: []Operand{{Cond, "NC"}, {Offset8, "e8"}}, Length: 2, Cycles: 2, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x31: {Name: "LDSP_d16", Mnemonic: "LD", Operands: []Operand{{Reg16, "SP"}, {Imm16, "n16"}}, Length: 3, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x32: {Name: "LDHLdec_A", Mnemonic: "LD", Operands: []Operand{{IndReg, "(HL-)"}, {Reg8, "A"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x33: {Name: "INC_SP", Mnemonic: "INC", Operands: []Operand{{Reg16, "SP"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x34: {Name: "INC_indHL", Mnemonic: "INC", Operands: []Operand{{IndReg, "(HL)"}}, Length: 1, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Reset, Affected, Unaffected}},
+    0x35: {Name: "DEC_indHL", Mnemonic: "DEC", Operands: []Operand{{IndReg, "(HL)"}}, Length: 1, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Set, Affected, Unaffected}},
+    0x36: {Name: "LDHL_d8", Mnemonic: "LD", Operands: []Operand{{IndReg, "(HL)"}, {Imm8, "n8"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x37: {Name: "SCF", Mnemonic: "SCF", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Reset, Reset, Set}},
+    0x38: {Name: "JRC_e", Mnemonic: "JR", Operands: []Operand{{Cond, "C"}, {Offset8, "e8"}}, Length: 2, Cycles: 2, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x39: {Name: "ADDHL_SP", Mnemonic: "ADD", Operands: []Operand{{Reg16, "HL"}, {Reg16, "SP"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Reset, Affected, Affected}},
+    0x3A: {Name: "LDA_HLdec", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL-)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x3B: {Name: "DEC_SP", Mnemonic: "DEC", Operands: []Operand{{Reg16, "SP"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x3C: {Name: "INC_A", Mnemonic: "INC", Operands: []Operand{{Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Unaffected}},
+    0x3D: {Name: "DEC_A", Mnemonic: "DEC", Operands: []Operand{{Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Unaffected}},
+    0x3E: {Name: "LDA_d8", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x3F: {Name: "CCF", Mnemonic: "CCF", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Reset, Reset, Affected}},
+    0x40: {Name: "LDB_B", Mnemonic: "LD", Operands: []Operand{{Reg8, "B"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x41: {Name: "LDB_C", Mnemonic: "LD", Operands: []Operand{{Reg8, "B"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x42: {Name: "LDB_D", Mnemonic: "LD", Operands: []Operand{{Reg8, "B"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x43: {Name: "LDB_E", Mnemonic: "LD", Operands: []Operand{{Reg8, "B"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x44: {Name: "LDB_H", Mnemonic: "LD", Operands: []Operand{{Reg8, "B"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x45: {Name: "LDB_L", Mnemonic: "LD", Operands: []Operand{{Reg8, "B"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x46: {Name: "LDB_HL", Mnemonic: "LD", Operands: []Operand{{Reg8, "B"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x47: {Name: "LDB_A", Mnemonic: "LD", Operands: []Operand{{Reg8, "B"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x48: {Name: "LDC_B", Mnemonic: "LD", Operands: []Operand{{Reg8, "C"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x49: {Name: "LDC_C", Mnemonic: "LD", Operands: []Operand{{Reg8, "C"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x4A: {Name: "LDC_D", Mnemonic: "LD", Operands: []Operand{{Reg8, "C"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x4B: {Name: "LDC_E", Mnemonic: "LD", Operands: []Operand{{Reg8, "C"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x4C: {Name: "LDC_H", Mnemonic: "LD", Operands: []Operand{{Reg8, "C"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x4D: {Name: "LDC_L", Mnemonic: "LD", Operands: []Operand{{Reg8, "C"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x4E: {Name: "LDC_HL", Mnemonic: "LD", Operands: []Operand{{Reg8, "C"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x4F: {Name: "LDC_A", Mnemonic: "LD", Operands: []Operand{{Reg8, "C"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x50: {Name: "LDD_B", Mnemonic: "LD", Operands: []Operand{{Reg8, "D"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x51: {Name: "LDD_C", Mnemonic: "LD", Operands: []Operand{{Reg8, "D"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x52: {Name: "LDD_D", Mnemonic: "LD", Operands: []Operand{{Reg8, "D"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x53: {Name: "LDD_E", Mnemonic: "LD", Operands: []Operand{{Reg8, "D"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x54: {Name: "LDD_H", Mnemonic: "LD", Operands: []Operand{{Reg8, "D"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x55: {Name: "LDD_L", Mnemonic: "LD", Operands: []Operand{{Reg8, "D"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x56: {Name: "LDD_HL", Mnemonic: "LD", Operands: []Operand{{Reg8, "D"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x57: {Name: "LDD_A", Mnemonic: "LD", Operands: []Operand{{Reg8, "D"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x58: {Name: "LDE_B", Mnemonic: "LD", Operands: []Operand{{Reg8, "E"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x59: {Name: "LDE_C", Mnemonic: "LD", Operands: []Operand{{Reg8, "E"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x5A: {Name: "LDE_D", Mnemonic: "LD", Operands: []Operand{{Reg8, "E"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x5B: {Name: "LDE_E", Mnemonic: "LD", Operands: []Operand{{Reg8, "E"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x5C: {Name: "LDE_H", Mnemonic: "LD", Operands: []Operand{{Reg8, "E"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x5D: {Name: "LDE_L", Mnemonic: "LD", Operands: []Operand{{Reg8, "E"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x5E: {Name: "LDE_HL", Mnemonic: "LD", Operands: []Operand{{Reg8, "E"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x5F: {Name: "LDE_A", Mnemonic: "LD", Operands: []Operand{{Reg8, "E"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x60: {Name: "LDH_B", Mnemonic: "LD", Operands: []Operand{{Reg8, "H"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x61: {Name: "LDH_C", Mnemonic: "LD", Operands: []Operand{{Reg8, "H"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x62: {Name: "LDH_D", Mnemonic: "LD", Operands: []Operand{{Reg8, "H"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x63: {Name: "LDH_E", Mnemonic: "LD", Operands: []Operand{{Reg8, "H"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x64: {Name: "LDH_H", Mnemonic: "LD", Operands: []Operand{{Reg8, "H"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x65: {Name: "LDH_L", Mnemonic: "LD", Operands: []Operand{{Reg8, "H"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x66: {Name: "LDH_HL", Mnemonic: "LD", Operands: []Operand{{Reg8, "H"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x67: {Name: "LDH_A", Mnemonic: "LD", Operands: []Operand{{Reg8, "H"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x68: {Name: "LDL_B", Mnemonic: "LD", Operands: []Operand{{Reg8, "L"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x69: {Name: "LDL_C", Mnemonic: "LD", Operands: []Operand{{Reg8, "L"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x6A: {Name: "LDL_D", Mnemonic: "LD", Operands: []Operand{{Reg8, "L"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x6B: {Name: "LDL_E", Mnemonic: "LD", Operands: []Operand{{Reg8, "L"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x6C: {Name: "LDL_H", Mnemonic: "LD", Operands: []Operand{{Reg8, "L"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x6D: {Name: "LDL_L", Mnemonic: "LD", Operands: []Operand{{Reg8, "L"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x6E: {Name: "LDL_HL", Mnemonic: "LD", Operands: []Operand{{Reg8, "L"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x6F: {Name: "LDL_A", Mnemonic: "LD", Operands: []Operand{{Reg8, "L"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x70: {Name: "LDHL_B", Mnemonic: "LD", Operands: []Operand{{IndReg, "(HL)"}, {Reg8, "B"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x71: {Name: "LDHL_C", Mnemonic: "LD", Operands: []Operand{{IndReg, "(HL)"}, {Reg8, "C"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x72: {Name: "LDHL_D", Mnemonic: "LD", Operands: []Operand{{IndReg, "(HL)"}, {Reg8, "D"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x73: {Name: "LDHL_E", Mnemonic: "LD", Operands: []Operand{{IndReg, "(HL)"}, {Reg8, "E"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x74: {Name: "LDHL_H", Mnemonic: "LD", Operands: []Operand{{IndReg, "(HL)"}, {Reg8, "H"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x75: {Name: "LDHL_L", Mnemonic: "LD", Operands: []Operand{{IndReg, "(HL)"}, {Reg8, "L"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x76: {Name: "HALT", Mnemonic: "HALT", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x77: {Name: "LDHL_A", Mnemonic: "LD", Operands: []Operand{{IndReg, "(HL)"}, {Reg8, "A"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x78: {Name: "LDA_B", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x79: {Name: "LDA_C", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x7A: {Name: "LDA_D", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x7B: {Name: "LDA_E", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x7C: {Name: "LDA_H", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x7D: {Name: "LDA_L", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x7E: {Name: "LDA_HL", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x7F: {Name: "LDA_A", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x80: {Name: "ADD_B", Mnemonic: "ADD", Operands: []Operand{{Reg8, "A"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x81: {Name: "ADD_C", Mnemonic: "ADD", Operands: []Operand{{Reg8, "A"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x82: {Name: "ADD_D", Mnemonic: "ADD", Operands: []Operand{{Reg8, "A"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x83: {Name: "ADD_E", Mnemonic: "ADD", Operands: []Operand{{Reg8, "A"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x84: {Name: "ADD_H", Mnemonic: "ADD", Operands: []Operand{{Reg8, "A"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x85: {Name: "ADD_L", Mnemonic: "ADD", Operands: []Operand{{Reg8, "A"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x86: {Name: "ADD_indHL", Mnemonic: "ADD", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x87: {Name: "ADD_A", Mnemonic: "ADD", Operands: []Operand{{Reg8, "A"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x88: {Name: "ADC_B", Mnemonic: "ADC", Operands: []Operand{{Reg8, "A"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x89: {Name: "ADC_C", Mnemonic: "ADC", Operands: []Operand{{Reg8, "A"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x8A: {Name: "ADC_D", Mnemonic: "ADC", Operands: []Operand{{Reg8, "A"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x8B: {Name: "ADC_E", Mnemonic: "ADC", Operands: []Operand{{Reg8, "A"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x8C: {Name: "ADC_H", Mnemonic: "ADC", Operands: []Operand{{Reg8, "A"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x8D: {Name: "ADC_L", Mnemonic: "ADC", Operands: []Operand{{Reg8, "A"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x8E: {Name: "ADC_indHL", Mnemonic: "ADC", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x8F: {Name: "ADC_A", Mnemonic: "ADC", Operands: []Operand{{Reg8, "A"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0x90: {Name: "SUB_B", Mnemonic: "SUB", Operands: []Operand{{Reg8, "A"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x91: {Name: "SUB_C", Mnemonic: "SUB", Operands: []Operand{{Reg8, "A"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x92: {Name: "SUB_D", Mnemonic: "SUB", Operands: []Operand{{Reg8, "A"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x93: {Name: "SUB_E", Mnemonic: "SUB", Operands: []Operand{{Reg8, "A"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x94: {Name: "SUB_H", Mnemonic: "SUB", Operands: []Operand{{Reg8, "A"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x95: {Name: "SUB_L", Mnemonic: "SUB", Operands: []Operand{{Reg8, "A"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x96: {Name: "SUB_indHL", Mnemonic: "SUB", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x97: {Name: "SUB_A", Mnemonic: "SUB", Operands: []Operand{{Reg8, "A"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x98: {Name: "SBC_B", Mnemonic: "SBC", Operands: []Operand{{Reg8, "A"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x99: {Name: "SBC_C", Mnemonic: "SBC", Operands: []Operand{{Reg8, "A"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x9A: {Name: "SBC_D", Mnemonic: "SBC", Operands: []Operand{{Reg8, "A"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x9B: {Name: "SBC_E", Mnemonic: "SBC", Operands: []Operand{{Reg8, "A"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x9C: {Name: "SBC_H", Mnemonic: "SBC", Operands: []Operand{{Reg8, "A"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x9D: {Name: "SBC_L", Mnemonic: "SBC", Operands: []Operand{{Reg8, "A"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x9E: {Name: "SBC_indHL", Mnemonic: "SBC", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Set, Affected, Affected}},
+    0x9F: {Name: "SBC_A", Mnemonic: "SBC", Operands: []Operand{{Reg8, "A"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xA0: {Name: "AND_B", Mnemonic: "AND", Operands: []Operand{{Reg8, "A"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Set, Reset}},
+    0xA1: {Name: "AND_C", Mnemonic: "AND", Operands: []Operand{{Reg8, "A"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Set, Reset}},
+    0xA2: {Name: "AND_D", Mnemonic: "AND", Operands: []Operand{{Reg8, "A"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Set, Reset}},
+    0xA3: {Name: "AND_E", Mnemonic: "AND", Operands: []Operand{{Reg8, "A"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Set, Reset}},
+    0xA4: {Name: "AND_H", Mnemonic: "AND", Operands: []Operand{{Reg8, "A"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Set, Reset}},
+    0xA5: {Name: "AND_L", Mnemonic: "AND", Operands: []Operand{{Reg8, "A"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Set, Reset}},
+    0xA6: {Name: "AND_indHL", Mnemonic: "AND", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Reset}},
+    0xA7: {Name: "AND_A", Mnemonic: "AND", Operands: []Operand{{Reg8, "A"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Set, Reset}},
+    0xA8: {Name: "XOR_B", Mnemonic: "XOR", Operands: []Operand{{Reg8, "A"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xA9: {Name: "XOR_C", Mnemonic: "XOR", Operands: []Operand{{Reg8, "A"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xAA: {Name: "XOR_D", Mnemonic: "XOR", Operands: []Operand{{Reg8, "A"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xAB: {Name: "XOR_E", Mnemonic: "XOR", Operands: []Operand{{Reg8, "A"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xAC: {Name: "XOR_H", Mnemonic: "XOR", Operands: []Operand{{Reg8, "A"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xAD: {Name: "XOR_L", Mnemonic: "XOR", Operands: []Operand{{Reg8, "A"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xAE: {Name: "XOR_indHL", Mnemonic: "XOR", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xAF: {Name: "XOR_A", Mnemonic: "XOR", Operands: []Operand{{Reg8, "A"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xB0: {Name: "OR_B", Mnemonic: "OR", Operands: []Operand{{Reg8, "A"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xB1: {Name: "OR_C", Mnemonic: "OR", Operands: []Operand{{Reg8, "A"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xB2: {Name: "OR_D", Mnemonic: "OR", Operands: []Operand{{Reg8, "A"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xB3: {Name: "OR_E", Mnemonic: "OR", Operands: []Operand{{Reg8, "A"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xB4: {Name: "OR_H", Mnemonic: "OR", Operands: []Operand{{Reg8, "A"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xB5: {Name: "OR_L", Mnemonic: "OR", Operands: []Operand{{Reg8, "A"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xB6: {Name: "OR_indHL", Mnemonic: "OR", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xB7: {Name: "OR_A", Mnemonic: "OR", Operands: []Operand{{Reg8, "A"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xB8: {Name: "CP_B", Mnemonic: "CP", Operands: []Operand{{Reg8, "A"}, {Reg8, "B"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xB9: {Name: "CP_C", Mnemonic: "CP", Operands: []Operand{{Reg8, "A"}, {Reg8, "C"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xBA: {Name: "CP_D", Mnemonic: "CP", Operands: []Operand{{Reg8, "A"}, {Reg8, "D"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xBB: {Name: "CP_E", Mnemonic: "CP", Operands: []Operand{{Reg8, "A"}, {Reg8, "E"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xBC: {Name: "CP_H", Mnemonic: "CP", Operands: []Operand{{Reg8, "A"}, {Reg8, "H"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xBD: {Name: "CP_L", Mnemonic: "CP", Operands: []Operand{{Reg8, "A"}, {Reg8, "L"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xBE: {Name: "CP_indHL", Mnemonic: "CP", Operands: []Operand{{Reg8, "A"}, {IndReg, "(HL)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xBF: {Name: "CP_A", Mnemonic: "CP", Operands: []Operand{{Reg8, "A"}, {Reg8, "A"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xC0: {Name: "RETNZ", Mnemonic: "RET", Operands: []Operand{{Cond, "NZ"}}, Length: 1, Cycles: 2, CyclesTaken: 5, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC1: {Name: "POP_BC", Mnemonic: "POP", Operands: []Operand{{Reg16, "BC"}}, Length: 1, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC2: {Name: "JPNZ_a16", Mnemonic: "JP", Operands: []Operand{{Cond, "NZ"}, {Addr16, "a16"}}, Length: 3, Cycles: 3, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC3: {Name: "JP_a16", Mnemonic: "JP", Operands: []Operand{{Addr16, "a16"}}, Length: 3, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC4: {Name: "CALLNZ_a16", Mnemonic: "CALL", Operands: []Operand{{Cond, "NZ"}, {Addr16, "a16"}}, Length: 3, Cycles: 3, CyclesTaken: 6, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC5: {Name: "PUSH_BC", Mnemonic: "PUSH", Operands: []Operand{{Reg16, "BC"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC6: {Name: "ADD_d8", Mnemonic: "ADD", Operands: []Operand{{Reg8, "A"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0xC7: {Name: "RST_00", Mnemonic: "RST", Operands: []Operand{{Vector, "00H"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC8: {Name: "RETZ", Mnemonic: "RET", Operands: []Operand{{Cond, "Z"}}, Length: 1, Cycles: 2, CyclesTaken: 5, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC9: {Name: "RET", Mnemonic: "RET", Operands: nil, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCA: {Name: "JPZ_a16", Mnemonic: "JP", Operands: []Operand{{Cond, "Z"}, {Addr16, "a16"}}, Length: 3, Cycles: 3, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCB: {Name: "PREFIX_CB", Mnemonic: "PREFIX", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCC: {Name: "CALLZ_a16", Mnemonic: "CALL", Operands: []Operand{{Cond, "Z"}, {Addr16, "a16"}}, Length: 3, Cycles: 3, CyclesTaken: 6, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCD: {Name: "CALL_a16", Mnemonic: "CALL", Operands: []Operand{{Addr16, "a16"}}, Length: 3, Cycles: 6, CyclesTaken: 6, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCE: {Name: "ADC_d8", Mnemonic: "ADC", Operands: []Operand{{Reg8, "A"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Affected, Affected}},
+    0xCF: {Name: "RST_08", Mnemonic: "RST", Operands: []Operand{{Vector, "08H"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD0: {Name: "RETNC", Mnemonic: "RET", Operands: []Operand{{Cond, "NC"}}, Length: 1, Cycles: 2, CyclesTaken: 5, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD1: {Name: "POP_DE", Mnemonic: "POP", Operands: []Operand{{Reg16, "DE"}}, Length: 1, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD2: {Name: "JPNC_a16", Mnemonic: "JP", Operands: []Operand{{Cond, "NC"}, {Addr16, "a16"}}, Length: 3, Cycles: 3, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD3: {Name: "ILLEGAL_D3", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xD4: {Name: "CALLNC_a16", Mnemonic: "CALL", Operands: []Operand{{Cond, "NC"}, {Addr16, "a16"}}, Length: 3, Cycles: 3, CyclesTaken: 6, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD5: {Name: "PUSH_DE", Mnemonic: "PUSH", Operands: []Operand{{Reg16, "DE"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD6: {Name: "SUB_d8", Mnemonic: "SUB", Operands: []Operand{{Reg8, "A"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xD7: {Name: "RST_10", Mnemonic: "RST", Operands: []Operand{{Vector, "10H"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD8: {Name: "RETC", Mnemonic: "RET", Operands: []Operand{{Cond, "C"}}, Length: 1, Cycles: 2, CyclesTaken: 5, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD9: {Name: "RETI", Mnemonic: "RETI", Operands: nil, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xDA: {Name: "JPC_a16", Mnemonic: "JP", Operands: []Operand{{Cond, "C"}, {Addr16, "a16"}}, Length: 3, Cycles: 3, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xDB: {Name: "ILLEGAL_DB", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xDC: {Name: "CALLC_a16", Mnemonic: "CALL", Operands: []Operand{{Cond, "C"}, {Addr16, "a16"}}, Length: 3, Cycles: 3, CyclesTaken: 6, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xDD: {Name: "ILLEGAL_DD", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xDE: {Name: "SBC_d8", Mnemonic: "SBC", Operands: []Operand{{Reg8, "A"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xDF: {Name: "RST_18", Mnemonic: "RST", Operands: []Operand{{Vector, "18H"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE0: {Name: "LDa8_A", Mnemonic: "LDH", Operands: []Operand{{Addr8, "(a8)"}, {Reg8, "A"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE1: {Name: "POP_HL", Mnemonic: "POP", Operands: []Operand{{Reg16, "HL"}}, Length: 1, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE2: {Name: "LDCind_A", Mnemonic: "LD", Operands: []Operand{{IndReg, "(C)"}, {Reg8, "A"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE3: {Name: "ILLEGAL_E3", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xE4: {Name: "ILLEGAL_E4", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xE5: {Name: "PUSH_HL", Mnemonic: "PUSH", Operands: []Operand{{Reg16, "HL"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE6: {Name: "AND_d8", Mnemonic: "AND", Operands: []Operand{{Reg8, "A"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Reset}},
+    0xE7: {Name: "RST_20", Mnemonic: "RST", Operands: []Operand{{Vector, "20H"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE8: {Name: "ADDSP_e", Mnemonic: "ADD", Operands: []Operand{{Reg16, "SP"}, {Offset8, "e8"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Reset, Reset, Affected, Affected}},
+    0xE9: {Name: "JP_HL", Mnemonic: "JP", Operands: []Operand{{Reg16, "HL"}}, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xEA: {Name: "LDa16_A", Mnemonic: "LD", Operands: []Operand{{Addr16, "(a16)"}, {Reg8, "A"}}, Length: 3, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xEB: {Name: "ILLEGAL_EB", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xEC: {Name: "ILLEGAL_EC", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xED: {Name: "ILLEGAL_ED", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xEE: {Name: "XOR_d8", Mnemonic: "XOR", Operands: []Operand{{Reg8, "A"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xEF: {Name: "RST_28", Mnemonic: "RST", Operands: []Operand{{Vector, "28H"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF0: {Name: "LDA_a8", Mnemonic: "LDH", Operands: []Operand{{Reg8, "A"}, {Addr8, "(a8)"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF1: {Name: "POP_AF", Mnemonic: "POP", Operands: []Operand{{Reg16, "AF"}}, Length: 1, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Affected, Affected, Affected}},
+    0xF2: {Name: "LDA_Cind", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {IndReg, "(C)"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF3: {Name: "DI", Mnemonic: "DI", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF4: {Name: "ILLEGAL_F4", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xF5: {Name: "PUSH_AF", Mnemonic: "PUSH", Operands: []Operand{{Reg16, "AF"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF6: {Name: "OR_d8", Mnemonic: "OR", Operands: []Operand{{Reg8, "A"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0xF7: {Name: "RST_30", Mnemonic: "RST", Operands: []Operand{{Vector, "30H"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF8: {Name: "LDHL_SPs8", Mnemonic: "LD", Operands: []Operand{{Reg16, "HL"}, {SPOffset8, "SP+e8"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Reset, Reset, Affected, Affected}},
+    0xF9: {Name: "LDSP_HL", Mnemonic: "LD", Operands: []Operand{{Reg16, "SP"}, {Reg16, "HL"}}, Length: 1, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xFA: {Name: "LDA_a16", Mnemonic: "LD", Operands: []Operand{{Reg8, "A"}, {Addr16, "(a16)"}}, Length: 3, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xFB: {Name: "EI", Mnemonic: "EI", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xFC: {Name: "ILLEGAL_FC", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xFD: {Name: "ILLEGAL_FD", Mnemonic: "ILLEGAL", Operands: nil, Length: 1, Cycles: 1, CyclesTaken: 1, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}, Illegal: true},
+    0xFE: {Name: "CP_d8", Mnemonic: "CP", Operands: []Operand{{Reg8, "A"}, {Imm8, "n8"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Set, Affected, Affected}},
+    0xFF: {Name: "RST_38", Mnemonic: "RST", Operands: []Operand{{Vector, "38H"}}, Length: 1, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+}
+
+// CBPrefixed holds the 256 opcodes following the 0xCB prefix.
+var CBPrefixed = [256]Opcode{
+    0x00: {Name: "RLC_B", Mnemonic: "RLC", Operands: []Operand{{Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x01: {Name: "RLC_C", Mnemonic: "RLC", Operands: []Operand{{Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x02: {Name: "RLC_D", Mnemonic: "RLC", Operands: []Operand{{Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x03: {Name: "RLC_E", Mnemonic: "RLC", Operands: []Operand{{Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x04: {Name: "RLC_H", Mnemonic: "RLC", Operands: []Operand{{Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x05: {Name: "RLC_L", Mnemonic: "RLC", Operands: []Operand{{Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x06: {Name: "RLC_indHL", Mnemonic: "RLC", Operands: []Operand{{IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x07: {Name: "RLC_A", Mnemonic: "RLC", Operands: []Operand{{Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x08: {Name: "RRC_B", Mnemonic: "RRC", Operands: []Operand{{Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x09: {Name: "RRC_C", Mnemonic: "RRC", Operands: []Operand{{Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x0A: {Name: "RRC_D", Mnemonic: "RRC", Operands: []Operand{{Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x0B: {Name: "RRC_E", Mnemonic: "RRC", Operands: []Operand{{Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x0C: {Name: "RRC_H", Mnemonic: "RRC", Operands: []Operand{{Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x0D: {Name: "RRC_L", Mnemonic: "RRC", Operands: []Operand{{Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x0E: {Name: "RRC_indHL", Mnemonic: "RRC", Operands: []Operand{{IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x0F: {Name: "RRC_A", Mnemonic: "RRC", Operands: []Operand{{Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x10: {Name: "RL_B", Mnemonic: "RL", Operands: []Operand{{Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x11: {Name: "RL_C", Mnemonic: "RL", Operands: []Operand{{Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x12: {Name: "RL_D", Mnemonic: "RL", Operands: []Operand{{Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x13: {Name: "RL_E", Mnemonic: "RL", Operands: []Operand{{Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x14: {Name: "RL_H", Mnemonic: "RL", Operands: []Operand{{Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x15: {Name: "RL_L", Mnemonic: "RL", Operands: []Operand{{Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x16: {Name: "RL_indHL", Mnemonic: "RL", Operands: []Operand{{IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x17: {Name: "RL_A", Mnemonic: "RL", Operands: []Operand{{Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x18: {Name: "RR_B", Mnemonic: "RR", Operands: []Operand{{Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x19: {Name: "RR_C", Mnemonic: "RR", Operands: []Operand{{Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x1A: {Name: "RR_D", Mnemonic: "RR", Operands: []Operand{{Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x1B: {Name: "RR_E", Mnemonic: "RR", Operands: []Operand{{Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x1C: {Name: "RR_H", Mnemonic: "RR", Operands: []Operand{{Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x1D: {Name: "RR_L", Mnemonic: "RR", Operands: []Operand{{Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x1E: {Name: "RR_indHL", Mnemonic: "RR", Operands: []Operand{{IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x1F: {Name: "RR_A", Mnemonic: "RR", Operands: []Operand{{Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x20: {Name: "SLA_B", Mnemonic: "SLA", Operands: []Operand{{Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x21: {Name: "SLA_C", Mnemonic: "SLA", Operands: []Operand{{Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x22: {Name: "SLA_D", Mnemonic: "SLA", Operands: []Operand{{Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x23: {Name: "SLA_E", Mnemonic: "SLA", Operands: []Operand{{Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x24: {Name: "SLA_H", Mnemonic: "SLA", Operands: []Operand{{Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x25: {Name: "SLA_L", Mnemonic: "SLA", Operands: []Operand{{Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x26: {Name: "SLA_indHL", Mnemonic: "SLA", Operands: []Operand{{IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x27: {Name: "SLA_A", Mnemonic: "SLA", Operands: []Operand{{Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x28: {Name: "SRA_B", Mnemonic: "SRA", Operands: []Operand{{Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x29: {Name: "SRA_C", Mnemonic: "SRA", Operands: []Operand{{Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x2A: {Name: "SRA_D", Mnemonic: "SRA", Operands: []Operand{{Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x2B: {Name: "SRA_E", Mnemonic: "SRA", Operands: []Operand{{Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x2C: {Name: "SRA_H", Mnemonic: "SRA", Operands: []Operand{{Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x2D: {Name: "SRA_L", Mnemonic: "SRA", Operands: []Operand{{Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x2E: {Name: "SRA_indHL", Mnemonic: "SRA", Operands: []Operand{{IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x2F: {Name: "SRA_A", Mnemonic: "SRA", Operands: []Operand{{Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x30: {Name: "SWAP_B", Mnemonic: "SWAP", Operands: []Operand{{Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0x31: {Name: "SWAP_C", Mnemonic: "SWAP", Operands: []Operand{{Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0x32: {Name: "SWAP_D", Mnemonic: "SWAP", Operands: []Operand{{Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0x33: {Name: "SWAP_E", Mnemonic: "SWAP", Operands: []Operand{{Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0x34: {Name: "SWAP_H", Mnemonic: "SWAP", Operands: []Operand{{Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0x35: {Name: "SWAP_L", Mnemonic: "SWAP", Operands: []Operand{{Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0x36: {Name: "SWAP_indHL", Mnemonic: "SWAP", Operands: []Operand{{IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0x37: {Name: "SWAP_A", Mnemonic: "SWAP", Operands: []Operand{{Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Reset}},
+    0x38: {Name: "SRL_B", Mnemonic: "SRL", Operands: []Operand{{Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x39: {Name: "SRL_C", Mnemonic: "SRL", Operands: []Operand{{Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x3A: {Name: "SRL_D", Mnemonic: "SRL", Operands: []Operand{{Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x3B: {Name: "SRL_E", Mnemonic: "SRL", Operands: []Operand{{Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x3C: {Name: "SRL_H", Mnemonic: "SRL", Operands: []Operand{{Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x3D: {Name: "SRL_L", Mnemonic: "SRL", Operands: []Operand{{Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x3E: {Name: "SRL_indHL", Mnemonic: "SRL", Operands: []Operand{{IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x3F: {Name: "SRL_A", Mnemonic: "SRL", Operands: []Operand{{Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Reset, Affected}},
+    0x40: {Name: "BIT0_B", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "0"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x41: {Name: "BIT0_C", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "0"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x42: {Name: "BIT0_D", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "0"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x43: {Name: "BIT0_E", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "0"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x44: {Name: "BIT0_H", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "0"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x45: {Name: "BIT0_L", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "0"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x46: {Name: "BIT0_indHL", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "0"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x47: {Name: "BIT0_A", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "0"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x48: {Name: "BIT1_B", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "1"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x49: {Name: "BIT1_C", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "1"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x4A: {Name: "BIT1_D", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "1"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x4B: {Name: "BIT1_E", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "1"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x4C: {Name: "BIT1_H", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "1"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x4D: {Name: "BIT1_L", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "1"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x4E: {Name: "BIT1_indHL", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "1"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x4F: {Name: "BIT1_A", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "1"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x50: {Name: "BIT2_B", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "2"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x51: {Name: "BIT2_C", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "2"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x52: {Name: "BIT2_D", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "2"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x53: {Name: "BIT2_E", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "2"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x54: {Name: "BIT2_H", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "2"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x55: {Name: "BIT2_L", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "2"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x56: {Name: "BIT2_indHL", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "2"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x57: {Name: "BIT2_A", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "2"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x58: {Name: "BIT3_B", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "3"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x59: {Name: "BIT3_C", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "3"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x5A: {Name: "BIT3_D", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "3"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x5B: {Name: "BIT3_E", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "3"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x5C: {Name: "BIT3_H", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "3"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x5D: {Name: "BIT3_L", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "3"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x5E: {Name: "BIT3_indHL", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "3"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x5F: {Name: "BIT3_A", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "3"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x60: {Name: "BIT4_B", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "4"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x61: {Name: "BIT4_C", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "4"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x62: {Name: "BIT4_D", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "4"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x63: {Name: "BIT4_E", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "4"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x64: {Name: "BIT4_H", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "4"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x65: {Name: "BIT4_L", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "4"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x66: {Name: "BIT4_indHL", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "4"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x67: {Name: "BIT4_A", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "4"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x68: {Name: "BIT5_B", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "5"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x69: {Name: "BIT5_C", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "5"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x6A: {Name: "BIT5_D", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "5"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x6B: {Name: "BIT5_E", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "5"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x6C: {Name: "BIT5_H", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "5"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x6D: {Name: "BIT5_L", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "5"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x6E: {Name: "BIT5_indHL", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "5"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x6F: {Name: "BIT5_A", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "5"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x70: {Name: "BIT6_B", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "6"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x71: {Name: "BIT6_C", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "6"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x72: {Name: "BIT6_D", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "6"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x73: {Name: "BIT6_E", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "6"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x74: {Name: "BIT6_H", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "6"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x75: {Name: "BIT6_L", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "6"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x76: {Name: "BIT6_indHL", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "6"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x77: {Name: "BIT6_A", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "6"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x78: {Name: "BIT7_B", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "7"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x79: {Name: "BIT7_C", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "7"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x7A: {Name: "BIT7_D", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "7"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x7B: {Name: "BIT7_E", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "7"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x7C: {Name: "BIT7_H", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "7"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x7D: {Name: "BIT7_L", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "7"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x7E: {Name: "BIT7_indHL", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "7"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 3, CyclesTaken: 3, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x7F: {Name: "BIT7_A", Mnemonic: "BIT", Operands: []Operand{{BitIndex, "7"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Affected, Reset, Set, Unaffected}},
+    0x80: {Name: "RES0_B", Mnemonic: "RES", Operands: []Operand{{BitIndex, "0"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x81: {Name: "RES0_C", Mnemonic: "RES", Operands: []Operand{{BitIndex, "0"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x82: {Name: "RES0_D", Mnemonic: "RES", Operands: []Operand{{BitIndex, "0"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x83: {Name: "RES0_E", Mnemonic: "RES", Operands: []Operand{{BitIndex, "0"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x84: {Name: "RES0_H", Mnemonic: "RES", Operands: []Operand{{BitIndex, "0"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x85: {Name: "RES0_L", Mnemonic: "RES", Operands: []Operand{{BitIndex, "0"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x86: {Name: "RES0_indHL", Mnemonic: "RES", Operands: []Operand{{BitIndex, "0"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x87: {Name: "RES0_A", Mnemonic: "RES", Operands: []Operand{{BitIndex, "0"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x88: {Name: "RES1_B", Mnemonic: "RES", Operands: []Operand{{BitIndex, "1"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x89: {Name: "RES1_C", Mnemonic: "RES", Operands: []Operand{{BitIndex, "1"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x8A: {Name: "RES1_D", Mnemonic: "RES", Operands: []Operand{{BitIndex, "1"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x8B: {Name: "RES1_E", Mnemonic: "RES", Operands: []Operand{{BitIndex, "1"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x8C: {Name: "RES1_H", Mnemonic: "RES", Operands: []Operand{{BitIndex, "1"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x8D: {Name: "RES1_L", Mnemonic: "RES", Operands: []Operand{{BitIndex, "1"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x8E: {Name: "RES1_indHL", Mnemonic: "RES", Operands: []Operand{{BitIndex, "1"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x8F: {Name: "RES1_A", Mnemonic: "RES", Operands: []Operand{{BitIndex, "1"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x90: {Name: "RES2_B", Mnemonic: "RES", Operands: []Operand{{BitIndex, "2"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x91: {Name: "RES2_C", Mnemonic: "RES", Operands: []Operand{{BitIndex, "2"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x92: {Name: "RES2_D", Mnemonic: "RES", Operands: []Operand{{BitIndex, "2"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x93: {Name: "RES2_E", Mnemonic: "RES", Operands: []Operand{{BitIndex, "2"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x94: {Name: "RES2_H", Mnemonic: "RES", Operands: []Operand{{BitIndex, "2"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x95: {Name: "RES2_L", Mnemonic: "RES", Operands: []Operand{{BitIndex, "2"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x96: {Name: "RES2_indHL", Mnemonic: "RES", Operands: []Operand{{BitIndex, "2"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x97: {Name: "RES2_A", Mnemonic: "RES", Operands: []Operand{{BitIndex, "2"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x98: {Name: "RES3_B", Mnemonic: "RES", Operands: []Operand{{BitIndex, "3"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x99: {Name: "RES3_C", Mnemonic: "RES", Operands: []Operand{{BitIndex, "3"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x9A: {Name: "RES3_D", Mnemonic: "RES", Operands: []Operand{{BitIndex, "3"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x9B: {Name: "RES3_E", Mnemonic: "RES", Operands: []Operand{{BitIndex, "3"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x9C: {Name: "RES3_H", Mnemonic: "RES", Operands: []Operand{{BitIndex, "3"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x9D: {Name: "RES3_L", Mnemonic: "RES", Operands: []Operand{{BitIndex, "3"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x9E: {Name: "RES3_indHL", Mnemonic: "RES", Operands: []Operand{{BitIndex, "3"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0x9F: {Name: "RES3_A", Mnemonic: "RES", Operands: []Operand{{BitIndex, "3"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xA0: {Name: "RES4_B", Mnemonic: "RES", Operands: []Operand{{BitIndex, "4"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xA1: {Name: "RES4_C", Mnemonic: "RES", Operands: []Operand{{BitIndex, "4"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xA2: {Name: "RES4_D", Mnemonic: "RES", Operands: []Operand{{BitIndex, "4"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xA3: {Name: "RES4_E", Mnemonic: "RES", Operands: []Operand{{BitIndex, "4"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xA4: {Name: "RES4_H", Mnemonic: "RES", Operands: []Operand{{BitIndex, "4"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xA5: {Name: "RES4_L", Mnemonic: "RES", Operands: []Operand{{BitIndex, "4"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xA6: {Name: "RES4_indHL", Mnemonic: "RES", Operands: []Operand{{BitIndex, "4"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xA7: {Name: "RES4_A", Mnemonic: "RES", Operands: []Operand{{BitIndex, "4"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xA8: {Name: "RES5_B", Mnemonic: "RES", Operands: []Operand{{BitIndex, "5"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xA9: {Name: "RES5_C", Mnemonic: "RES", Operands: []Operand{{BitIndex, "5"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xAA: {Name: "RES5_D", Mnemonic: "RES", Operands: []Operand{{BitIndex, "5"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xAB: {Name: "RES5_E", Mnemonic: "RES", Operands: []Operand{{BitIndex, "5"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xAC: {Name: "RES5_H", Mnemonic: "RES", Operands: []Operand{{BitIndex, "5"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xAD: {Name: "RES5_L", Mnemonic: "RES", Operands: []Operand{{BitIndex, "5"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xAE: {Name: "RES5_indHL", Mnemonic: "RES", Operands: []Operand{{BitIndex, "5"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xAF: {Name: "RES5_A", Mnemonic: "RES", Operands: []Operand{{BitIndex, "5"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xB0: {Name: "RES6_B", Mnemonic: "RES", Operands: []Operand{{BitIndex, "6"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xB1: {Name: "RES6_C", Mnemonic: "RES", Operands: []Operand{{BitIndex, "6"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xB2: {Name: "RES6_D", Mnemonic: "RES", Operands: []Operand{{BitIndex, "6"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xB3: {Name: "RES6_E", Mnemonic: "RES", Operands: []Operand{{BitIndex, "6"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xB4: {Name: "RES6_H", Mnemonic: "RES", Operands: []Operand{{BitIndex, "6"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xB5: {Name: "RES6_L", Mnemonic: "RES", Operands: []Operand{{BitIndex, "6"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xB6: {Name: "RES6_indHL", Mnemonic: "RES", Operands: []Operand{{BitIndex, "6"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xB7: {Name: "RES6_A", Mnemonic: "RES", Operands: []Operand{{BitIndex, "6"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xB8: {Name: "RES7_B", Mnemonic: "RES", Operands: []Operand{{BitIndex, "7"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xB9: {Name: "RES7_C", Mnemonic: "RES", Operands: []Operand{{BitIndex, "7"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xBA: {Name: "RES7_D", Mnemonic: "RES", Operands: []Operand{{BitIndex, "7"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xBB: {Name: "RES7_E", Mnemonic: "RES", Operands: []Operand{{BitIndex, "7"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xBC: {Name: "RES7_H", Mnemonic: "RES", Operands: []Operand{{BitIndex, "7"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xBD: {Name: "RES7_L", Mnemonic: "RES", Operands: []Operand{{BitIndex, "7"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xBE: {Name: "RES7_indHL", Mnemonic: "RES", Operands: []Operand{{BitIndex, "7"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xBF: {Name: "RES7_A", Mnemonic: "RES", Operands: []Operand{{BitIndex, "7"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC0: {Name: "SET0_B", Mnemonic: "SET", Operands: []Operand{{BitIndex, "0"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC1: {Name: "SET0_C", Mnemonic: "SET", Operands: []Operand{{BitIndex, "0"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC2: {Name: "SET0_D", Mnemonic: "SET", Operands: []Operand{{BitIndex, "0"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC3: {Name: "SET0_E", Mnemonic: "SET", Operands: []Operand{{BitIndex, "0"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC4: {Name: "SET0_H", Mnemonic: "SET", Operands: []Operand{{BitIndex, "0"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC5: {Name: "SET0_L", Mnemonic: "SET", Operands: []Operand{{BitIndex, "0"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC6: {Name: "SET0_indHL", Mnemonic: "SET", Operands: []Operand{{BitIndex, "0"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC7: {Name: "SET0_A", Mnemonic: "SET", Operands: []Operand{{BitIndex, "0"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC8: {Name: "SET1_B", Mnemonic: "SET", Operands: []Operand{{BitIndex, "1"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xC9: {Name: "SET1_C", Mnemonic: "SET", Operands: []Operand{{BitIndex, "1"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCA: {Name: "SET1_D", Mnemonic: "SET", Operands: []Operand{{BitIndex, "1"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCB: {Name: "SET1_E", Mnemonic: "SET", Operands: []Operand{{BitIndex, "1"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCC: {Name: "SET1_H", Mnemonic: "SET", Operands: []Operand{{BitIndex, "1"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCD: {Name: "SET1_L", Mnemonic: "SET", Operands: []Operand{{BitIndex, "1"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCE: {Name: "SET1_indHL", Mnemonic: "SET", Operands: []Operand{{BitIndex, "1"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xCF: {Name: "SET1_A", Mnemonic: "SET", Operands: []Operand{{BitIndex, "1"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD0: {Name: "SET2_B", Mnemonic: "SET", Operands: []Operand{{BitIndex, "2"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD1: {Name: "SET2_C", Mnemonic: "SET", Operands: []Operand{{BitIndex, "2"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD2: {Name: "SET2_D", Mnemonic: "SET", Operands: []Operand{{BitIndex, "2"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD3: {Name: "SET2_E", Mnemonic: "SET", Operands: []Operand{{BitIndex, "2"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD4: {Name: "SET2_H", Mnemonic: "SET", Operands: []Operand{{BitIndex, "2"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD5: {Name: "SET2_L", Mnemonic: "SET", Operands: []Operand{{BitIndex, "2"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD6: {Name: "SET2_indHL", Mnemonic: "SET", Operands: []Operand{{BitIndex, "2"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD7: {Name: "SET2_A", Mnemonic: "SET", Operands: []Operand{{BitIndex, "2"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD8: {Name: "SET3_B", Mnemonic: "SET", Operands: []Operand{{BitIndex, "3"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xD9: {Name: "SET3_C", Mnemonic: "SET", Operands: []Operand{{BitIndex, "3"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xDA: {Name: "SET3_D", Mnemonic: "SET", Operands: []Operand{{BitIndex, "3"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xDB: {Name: "SET3_E", Mnemonic: "SET", Operands: []Operand{{BitIndex, "3"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xDC: {Name: "SET3_H", Mnemonic: "SET", Operands: []Operand{{BitIndex, "3"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xDD: {Name: "SET3_L", Mnemonic: "SET", Operands: []Operand{{BitIndex, "3"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xDE: {Name: "SET3_indHL", Mnemonic: "SET", Operands: []Operand{{BitIndex, "3"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xDF: {Name: "SET3_A", Mnemonic: "SET", Operands: []Operand{{BitIndex, "3"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE0: {Name: "SET4_B", Mnemonic: "SET", Operands: []Operand{{BitIndex, "4"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE1: {Name: "SET4_C", Mnemonic: "SET", Operands: []Operand{{BitIndex, "4"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE2: {Name: "SET4_D", Mnemonic: "SET", Operands: []Operand{{BitIndex, "4"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE3: {Name: "SET4_E", Mnemonic: "SET", Operands: []Operand{{BitIndex, "4"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE4: {Name: "SET4_H", Mnemonic: "SET", Operands: []Operand{{BitIndex, "4"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE5: {Name: "SET4_L", Mnemonic: "SET", Operands: []Operand{{BitIndex, "4"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE6: {Name: "SET4_indHL", Mnemonic: "SET", Operands: []Operand{{BitIndex, "4"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE7: {Name: "SET4_A", Mnemonic: "SET", Operands: []Operand{{BitIndex, "4"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE8: {Name: "SET5_B", Mnemonic: "SET", Operands: []Operand{{BitIndex, "5"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xE9: {Name: "SET5_C", Mnemonic: "SET", Operands: []Operand{{BitIndex, "5"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xEA: {Name: "SET5_D", Mnemonic: "SET", Operands: []Operand{{BitIndex, "5"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xEB: {Name: "SET5_E", Mnemonic: "SET", Operands: []Operand{{BitIndex, "5"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xEC: {Name: "SET5_H", Mnemonic: "SET", Operands: []Operand{{BitIndex, "5"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xED: {Name: "SET5_L", Mnemonic: "SET", Operands: []Operand{{BitIndex, "5"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xEE: {Name: "SET5_indHL", Mnemonic: "SET", Operands: []Operand{{BitIndex, "5"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xEF: {Name: "SET5_A", Mnemonic: "SET", Operands: []Operand{{BitIndex, "5"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF0: {Name: "SET6_B", Mnemonic: "SET", Operands: []Operand{{BitIndex, "6"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF1: {Name: "SET6_C", Mnemonic: "SET", Operands: []Operand{{BitIndex, "6"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF2: {Name: "SET6_D", Mnemonic: "SET", Operands: []Operand{{BitIndex, "6"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF3: {Name: "SET6_E", Mnemonic: "SET", Operands: []Operand{{BitIndex, "6"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF4: {Name: "SET6_H", Mnemonic: "SET", Operands: []Operand{{BitIndex, "6"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF5: {Name: "SET6_L", Mnemonic: "SET", Operands: []Operand{{BitIndex, "6"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF6: {Name: "SET6_indHL", Mnemonic: "SET", Operands: []Operand{{BitIndex, "6"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF7: {Name: "SET6_A", Mnemonic: "SET", Operands: []Operand{{BitIndex, "6"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF8: {Name: "SET7_B", Mnemonic: "SET", Operands: []Operand{{BitIndex, "7"}, {Reg8, "B"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xF9: {Name: "SET7_C", Mnemonic: "SET", Operands: []Operand{{BitIndex, "7"}, {Reg8, "C"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xFA: {Name: "SET7_D", Mnemonic: "SET", Operands: []Operand{{BitIndex, "7"}, {Reg8, "D"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xFB: {Name: "SET7_E", Mnemonic: "SET", Operands: []Operand{{BitIndex, "7"}, {Reg8, "E"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xFC: {Name: "SET7_H", Mnemonic: "SET", Operands: []Operand{{BitIndex, "7"}, {Reg8, "H"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xFD: {Name: "SET7_L", Mnemonic: "SET", Operands: []Operand{{BitIndex, "7"}, {Reg8, "L"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xFE: {Name: "SET7_indHL", Mnemonic: "SET", Operands: []Operand{{BitIndex, "7"}, {IndReg, "(HL)"}}, Length: 2, Cycles: 4, CyclesTaken: 4, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+    0xFF: {Name: "SET7_A", Mnemonic: "SET", Operands: []Operand{{BitIndex, "7"}, {Reg8, "A"}}, Length: 2, Cycles: 2, CyclesTaken: 2, Flags: Flags{Unaffected, Unaffected, Unaffected, Unaffected}},
+}
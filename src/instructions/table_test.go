@@ -0,0 +1,184 @@
+package instructions
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+)
+
+// operandBytes returns the number of bytes an operand takes after the opcode.
+func operandBytes(kind OperandKind) int {
+    switch kind {
+    case Imm8, Offset8, SPOffset8, Addr8:
+        return 1
+    case Imm16, Addr16:
+        return 2
+    default:
+        return 0
+    }
+}
+
+// TestTableLengthsMatchOperands verifies that every length is the opcode plus its immediate operands.
+func TestTableLengthsMatchOperands(t *testing.T) {
+
+    for op, opcode := range Unprefixed {
+        want := 1
+        for _, operand := range opcode.Operands {
+            want += operandBytes(operand.Kind)
+        }
+
+        if opcode.Length != want {
+            t.Error(opcode.Name, " (", op, "): length ", opcode.Length, ", operands need ", want)
+        }
+    }
+
+    for op, opcode := range CBPrefixed {
+        if opcode.Length != 2 {
+            t.Error(opcode.Name, " (CB ", op, "): length should be 2, instead got ", opcode.Length)
+        }
+    }
+}
+
+// TestTableCycles verifies that durations are set, and only conditional instructions have a taken duration.
+func TestTableCycles(t *testing.T) {
+
+    for _, table := range [][256]Opcode{Unprefixed, CBPrefixed} {
+        for _, opcode := range table {
+
+            if opcode.Cycles < 1 || opcode.CyclesTaken < opcode.Cycles {
+                t.Error(opcode.Name, ": invalid cycles ", opcode.Cycles, "/", opcode.CyclesTaken)
+            }
+
+            hasCondition := false
+            for _, operand := range opcode.Operands {
+                hasCondition = hasCondition || operand.Kind == Cond
+            }
+
+            if hasCondition != opcode.IsConditional() {
+                t.Error(opcode.Name, ": conditional duration does not match its operands.")
+            }
+        }
+    }
+}
+
+// TestTableNamesAreUnique verifies that no two opcodes generate the same constant.
+func TestTableNamesAreUnique(t *testing.T) {
+
+    seen := map[string]bool{}
+
+    for _, table := range [][256]Opcode{Unprefixed, CBPrefixed} {
+        for _, opcode := range table {
+            if opcode.Name == "" || opcode.Mnemonic == "" {
+                t.Error("Opcode ", opcode, " has no name or mnemonic.")
+            }
+
+            if seen[opcode.Name] {
+                t.Error("Duplicate opcode name: ", opcode.Name)
+            }
+            seen[opcode.Name] = true
+        }
+    }
+}
+
+func TestTableIllegalOpcodes(t *testing.T) {
+
+    want := map[int]bool{0xD3: true, 0xDB: true, 0xDD: true, 0xE3: true, 0xE4: true, 0xEB: true,
+                         0xEC: true, 0xED: true, 0xF4: true, 0xFC: true, 0xFD: true}
+
+    for op, opcode := range Unprefixed {
+        if opcode.Illegal != want[op] {
+            t.Error(opcode.Name, ": illegal should be ", want[op])
+        }
+    }
+}
+
+// TestCBTableEncoding verifies that the CB table agrees with the CB_* encoding constants.
+func TestCBTableEncoding(t *testing.T) {
+
+    mnemonics := map[int]string{CB_RLC: "RLC", CB_RRC: "RRC", CB_RL: "RL", CB_RR: "RR",
+                                CB_SLA: "SLA", CB_SRA: "SRA", CB_SWAP: "SWAP", CB_SRL: "SRL"}
+
+    for op, opcode := range CBPrefixed {
+        var want string
+        switch op & 0xC0 {
+        case CB_BIT:
+            want = "BIT"
+        case CB_RES:
+            want = "RES"
+        case CB_SET:
+            want = "SET"
+        default:
+            want = mnemonics[op & 0x38]
+        }
+
+        if opcode.Mnemonic != want {
+            t.Error("CB ", op, ": mnemonic should be ", want, ", instead got ", opcode.Mnemonic)
+        }
+
+        if (op & 0x07 == CB_indHL) != (opcode.Operands[len(opcode.Operands) - 1].Name == "(HL)") {
+            t.Error("CB ", op, ": operand does not match the encoding, got ", opcode)
+        }
+    }
+}
+
+func TestOpcodeString(t *testing.T) {
+
+    if s := Unprefixed[LDB_IM].String(); s != "LD B, n8" {
+        t.Error("LDB_IM should be \"LD B, n8\", instead got ", s)
+    }
+
+    if s := CBPrefixed[BIT7_indHL].String(); s != "BIT 7, (HL)" {
+        t.Error("BIT7_indHL should be \"BIT 7, (HL)\", instead got ", s)
+    }
+
+    if s := Unprefixed[ADD_d8].Flags.String(); s != "Z0HC" {
+        t.Error("ADD_d8 flags should be \"Z0HC\", instead got ", s)
+    }
+}
+
+// TestGeneratedConstantsAreUpToDate verifies that opcodes.go matches the tables,
+// run "go generate" if it fails.
+func TestGeneratedConstantsAreUpToDate(t *testing.T) {
+
+    file, err := parser.ParseFile(token.NewFileSet(), "opcodes.go", nil, 0)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    tables := [][256]Opcode{Unprefixed, CBPrefixed}
+    blocks := 0
+
+    for _, decl := range file.Decls {
+        gen, ok := decl.(*ast.GenDecl)
+        if !ok || gen.Tok != token.CONST {
+            continue
+        }
+        if blocks == len(tables) {
+            t.Fatal("opcodes.go has more const blocks than tables.")
+        }
+
+        table := tables[blocks]
+        if len(gen.Specs) != len(table) {
+            t.Error("Const block ", blocks, " has ", len(gen.Specs), " constants, want ", len(table))
+        }
+
+        for _, spec := range gen.Specs {
+            value := spec.(*ast.ValueSpec)
+            op, err := strconv.ParseUint(value.Values[0].(*ast.BasicLit).Value, 0, 8)
+            if err != nil {
+                t.Fatal(err)
+            }
+
+            if name := value.Names[0].Name; table[op].Name != name {
+                t.Error(name, " = ", op, ", but the table names it ", table[op].Name)
+            }
+        }
+        blocks++
+    }
+
+    if blocks != len(tables) {
+        t.Error("opcodes.go should have ", len(tables), " const blocks, instead got ", blocks)
+    }
+}
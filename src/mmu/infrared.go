@@ -0,0 +1,62 @@
+package mmu
+
+// IRHandler is called with the new state of an IR LED every time it changes.
+type IRHandler = func(on bool)
+
+// IRPort is an infrared LED and sensor: the CGB RP register, and the IR port of HuC
+// cartridges. The game turns the LED on and off, reported through OnIR, and senses the light
+// of the other device, set by the host with SetIRLight. Linking two emulators is forwarding
+// the LED of each to the light of the other.
+type IRPort interface {
+
+    // OnIR subscribes handler to the LED. Handlers are called in the order they subscribed,
+    // from the goroutine running the CPU.
+    OnIR(handler IRHandler)
+
+    // SetIRLight tells the port whether its sensor sees light.
+    SetIRLight(on bool)
+}
+
+// infrared is the RP register (0xFF56):
+//
+// Bit 0:    LED, written by the game: 1 emits light.
+// Bit 1:    Sensor, read only: 0 when light is received, 1 otherwise.
+// Bits 2-5: Unused, read as 1.
+// Bits 6-7: Sensor read enable: 3 enables bit 1, otherwise it always reads 1.
+type infrared struct {
+    rp          byte
+    light       bool
+    handlers    []IRHandler
+}
+
+func (r *infrared) read() byte {
+
+    value := r.rp & 0xC1 | 0x3E
+    if r.rp & 0xC0 == 0xC0 && r.light {
+        value &^= 0x02
+    }
+    return value
+}
+
+func (r *infrared) write(value byte) {
+
+    led := r.rp & 0x01
+    r.rp = value & 0xC1
+    if r.rp & 0x01 == led {
+        return
+    }
+
+    for _, handler := range r.handlers {
+        handler(r.rp & 0x01 != 0)
+    }
+}
+
+// OnIR subscribes handler to the LED of the RP register, see IRPort.
+func (m *MMU) OnIR(handler IRHandler) {
+    m.ir.handlers = append(m.ir.handlers, handler)
+}
+
+// SetIRLight tells the sensor of the RP register whether it sees light, see IRPort.
+func (m *MMU) SetIRLight(on bool) {
+    m.ir.light = on
+}
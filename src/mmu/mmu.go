@@ -0,0 +1,351 @@
+package mmu
+
+// Game Boy Color memory map:
+//
+// 0x0000-0x3FFF: ROM bank 00, from cartridge.
+// 0x4000-0x7FFF: ROM bank 01-NN, from cartridge, switchable by the mapper.
+// 0x8000-0x9FFF: VRAM, 2 banks of 8 KiB switchable through VBK.
+// 0xA000-0xBFFF: External RAM, from cartridge.
+// 0xC000-0xCFFF: WRAM bank 0.
+// 0xD000-0xDFFF: WRAM bank 1-7, switchable through SVBK.
+// 0xE000-0xFDFF: Echo RAM, mirror of 0xC000-0xDDFF.
+// 0xFE00-0xFE9F: OAM.
+// 0xFEA0-0xFEFF: Not usable.
+// 0xFF00-0xFF7F: I/O registers.
+// 0xFF80-0xFFFE: HRAM.
+// 0xFFFF:        IE register.
+
+const (
+    // OpenBus is read from addresses nothing drives.
+    OpenBus = 0xFF
+
+    pageSize    = 0x100
+    pageCount   = 0x100
+
+    vramBankSize    = 0x2000
+    wramBankSize    = 0x1000
+    oamSize         = 0xA0
+    hramSize        = 0x7F
+)
+
+// I/O registers handled by the MMU itself.
+const (
+    IFAddress   = 0xFF0F // Interrupt Flag.
+    KEY1Address = 0xFF4D // CGB speed switch.
+    VBKAddress  = 0xFF4F // CGB VRAM bank.
+    RPAddress   = 0xFF56 // CGB infrared port.
+    SVBKAddress = 0xFF70 // CGB WRAM bank.
+    IEAddress   = 0xFFFF // Interrupt Enable.
+)
+
+// Cartridge is the memory behind 0x0000-0x7FFF (ROM) and 0xA000-0xBFFF (external RAM).
+// Writes to the ROM area go to the cartridge too, that is how mappers switch banks.
+type Cartridge interface {
+    Read(address uint16) byte
+    Write(address uint16, value byte)
+}
+
+// PageMapper can be implemented by a Cartridge to let the MMU read it without calling Read.
+//
+// Page returns the 256 bytes currently visible at the page (address >> 8), or nil if
+// reads from that page must go through Read. The MMU asks again after every write to
+// 0x0000-0x7FFF, which is where mappers switch banks.
+type PageMapper interface {
+    Page(page byte) []byte
+}
+
+// Component is anything that runs alongside the CPU: timer, PPU, APU, DMA.
+type Component interface {
+
+    // Tick advances the component by the given machine cycles.
+    Tick(cycles int)
+}
+
+// SpeedAware can be implemented by a Component whose machine cycles depend on the CPU
+// speed, it is told about every speed switch.
+type SpeedAware interface {
+    SetDoubleSpeed(on bool)
+}
+
+// IOHandler reads and writes one I/O register, nil funcs make it write-only or read-only.
+type IOHandler struct {
+    Read    func() byte
+    Write   func(value byte)
+}
+
+// MMU routes every CPU access to the owner of the address.
+//
+// Reads go through a page table with one entry per 256 bytes: pages backed by plain
+// memory (ROM pages of a PageMapper, VRAM, WRAM, echo RAM) are read directly from the
+// table, everything else goes through the owner.
+type MMU struct {
+
+    readPages   [pageCount][]byte
+    writePages  [pageCount][]byte
+
+    cartridge   Cartridge
+
+    vram        [2][vramBankSize]byte
+    vramBank    int
+
+    wram        [8][wramBankSize]byte
+    wramBank    int
+
+    oam         [oamSize]byte
+    hram        [hramSize]byte
+
+    io          [0x80]IOHandler
+
+    components  []Component
+
+    // Registers owned by the MMU.
+    interruptFlag   byte
+    interruptEnable byte
+    key1            byte
+
+    // Infrared port, see infrared.go.
+    ir              infrared
+}
+
+// New returns an MMU with no cartridge, VRAM bank 0 and WRAM bank 1 selected.
+func New() *MMU {
+
+    m := &MMU{wramBank: 1}
+
+    m.RegisterIO(IFAddress, IOHandler{
+        // Upper 3 bits are not wired and read as 1.
+        Read:   func() byte { return m.interruptFlag | 0xE0 },
+        Write:  func(value byte) { m.interruptFlag = value & 0x1F },
+    })
+
+    m.RegisterIO(KEY1Address, IOHandler{
+        // Only bit 0 is writable, unused bits read as 1.
+        Read:   func() byte { return m.key1 | 0x7E },
+        Write:  func(value byte) { m.key1 = m.key1 & 0x80 | value & 0x01 },
+    })
+
+    m.RegisterIO(RPAddress, IOHandler{
+        Read:   m.ir.read,
+        Write:  m.ir.write,
+    })
+
+    m.RegisterIO(VBKAddress, IOHandler{
+        Read:   func() byte { return byte(m.vramBank) | 0xFE },
+        Write:  func(value byte) {
+            m.vramBank = int(value & 0x01)
+            m.mapVRAM()
+        },
+    })
+
+    m.RegisterIO(SVBKAddress, IOHandler{
+        Read:   func() byte { return byte(m.wramBank) | 0xF8 },
+        Write:  func(value byte) {
+
+            // Selecting bank 0 selects bank 1.
+            m.wramBank = int(value & 0x07)
+            if m.wramBank == 0 {
+                m.wramBank = 1
+            }
+            m.mapWRAM()
+        },
+    })
+
+    m.mapVRAM()
+    m.mapWRAM()
+
+    return m
+}
+
+// Read returns the byte at address, OpenBus if nothing is mapped there.
+func (m *MMU) Read(address uint16) byte {
+
+    if page := m.readPages[address >> 8]; page != nil {
+        return page[address & 0xFF]
+    }
+
+    return m.readSlow(address)
+}
+
+// IsPlain reports whether reading address returns plain memory, with no side effects: the
+// pages read directly from the page table. ROM pages are plain only while the cartridge maps them.
+func (m *MMU) IsPlain(address uint16) bool {
+    return m.readPages[address >> 8] != nil
+}
+
+// Write stores value at address, writes to read-only or unmapped addresses are ignored
+// unless their owner handles them.
+func (m *MMU) Write(address uint16, value byte) {
+
+    if page := m.writePages[address >> 8]; page != nil {
+        page[address & 0xFF] = value
+        return
+    }
+
+    m.writeSlow(address, value)
+}
+
+// LoadCartridge inserts the cartridge, nil removes it.
+func (m *MMU) LoadCartridge(c Cartridge) {
+    m.cartridge = c
+    m.mapCartridge()
+}
+
+// RegisterIO makes h the owner of the I/O register at address (0xFF00-0xFF7F).
+// It replaces any previous handler.
+func (m *MMU) RegisterIO(address uint16, h IOHandler) {
+
+    if address < 0xFF00 || address > 0xFF7F {
+        panic("mmu: I/O registers are in 0xFF00-0xFF7F")
+    }
+    m.io[address - 0xFF00] = h
+}
+
+// AddComponent makes c tick along with the CPU, components tick in the order they were added.
+func (m *MMU) AddComponent(c Component) {
+    m.components = append(m.components, c)
+
+    if s, ok := c.(SpeedAware); ok {
+        s.SetDoubleSpeed(m.IsDoubleSpeed())
+    }
+}
+
+// Tick advances every component by the machine cycles the CPU has just used.
+func (m *MMU) Tick(cycles int) {
+    for _, c := range m.components {
+        c.Tick(cycles)
+    }
+}
+
+// RequestInterrupt sets the bit of the interrupt in IF, for devices raising interrupt lines.
+func (m *MMU) RequestInterrupt(bit uint) {
+    m.interruptFlag |= 1 << bit
+}
+
+// IsDoubleSpeed reports whether the CGB runs in double speed mode (KEY1 bit 7).
+func (m *MMU) IsDoubleSpeed() bool {
+    return m.key1 & 0x80 != 0
+}
+
+// SwitchSpeed performs an armed speed switch, as done by STOP: the speed is toggled and the
+// armed bit cleared. It reports whether a switch was armed.
+func (m *MMU) SwitchSpeed() bool {
+
+    if m.key1 & 0x01 == 0 {
+        return false
+    }
+    m.key1 = (m.key1 ^ 0x80) &^ 0x01
+
+    for _, c := range m.components {
+        if s, ok := c.(SpeedAware); ok {
+            s.SetDoubleSpeed(m.IsDoubleSpeed())
+        }
+    }
+    return true
+}
+
+func (m *MMU) readSlow(address uint16) byte {
+
+    switch {
+    case address < 0x8000, address >= 0xA000 && address < 0xC000:
+        if m.cartridge == nil {
+            return OpenBus
+        }
+        return m.cartridge.Read(address)
+
+    case address < 0xFEA0 && address >= 0xFE00:
+        return m.oam[address - 0xFE00]
+
+    case address < 0xFF00 && address >= 0xFEA0:
+        // Not usable, the value read differs between CGB revisions.
+        return OpenBus
+
+    case address < 0xFF80 && address >= 0xFF00:
+        if read := m.io[address - 0xFF00].Read; read != nil {
+            return read()
+        }
+        return OpenBus
+
+    case address < 0xFFFF && address >= 0xFF80:
+        return m.hram[address - 0xFF80]
+
+    case address == IEAddress:
+        return m.interruptEnable
+    }
+
+    return OpenBus
+}
+
+func (m *MMU) writeSlow(address uint16, value byte) {
+
+    switch {
+    case address < 0x8000:
+        if m.cartridge != nil {
+            m.cartridge.Write(address, value)
+
+            // Banks may have been switched.
+            m.mapCartridge()
+        }
+
+    case address >= 0xA000 && address < 0xC000:
+        if m.cartridge != nil {
+            m.cartridge.Write(address, value)
+        }
+
+    case address < 0xFEA0 && address >= 0xFE00:
+        m.oam[address - 0xFE00] = value
+
+    case address < 0xFF00 && address >= 0xFEA0:
+        // Not usable, writes are ignored.
+
+    case address < 0xFF80 && address >= 0xFF00:
+        if write := m.io[address - 0xFF00].Write; write != nil {
+            write(value)
+        }
+
+    case address < 0xFFFF && address >= 0xFF80:
+        m.hram[address - 0xFF80] = value
+
+    case address == IEAddress:
+        m.interruptEnable = value
+    }
+}
+
+// mapRange points pages [first, first + len(mem) / pageSize) to mem.
+func (m *MMU) mapRange(first int, mem []byte, writable bool) {
+
+    for i := 0; i < len(mem) / pageSize; i++ {
+        page := mem[i * pageSize : (i + 1) * pageSize : (i + 1) * pageSize]
+        m.readPages[first + i] = page
+        if writable {
+            m.writePages[first + i] = page
+        }
+    }
+}
+
+// mapVRAM maps the selected VRAM bank at 0x8000-0x9FFF.
+func (m *MMU) mapVRAM() {
+    m.mapRange(0x80, m.vram[m.vramBank][:], true)
+}
+
+// mapWRAM maps WRAM at 0xC000-0xDFFF and its mirror at 0xE000-0xFDFF.
+func (m *MMU) mapWRAM() {
+
+    m.mapRange(0xC0, m.wram[0][:], true)
+    m.mapRange(0xD0, m.wram[m.wramBank][:], true)
+
+    m.mapRange(0xE0, m.wram[0][:], true)
+    m.mapRange(0xF0, m.wram[m.wramBank][:0xE00], true)
+}
+
+// mapCartridge asks the cartridge for its ROM pages, external RAM always goes through it.
+func (m *MMU) mapCartridge() {
+
+    mapper, ok := m.cartridge.(PageMapper)
+
+    for page := 0x00; page < 0x80; page++ {
+        m.readPages[page] = nil
+        if ok {
+            m.readPages[page] = mapper.Page(byte(page))
+        }
+    }
+}
@@ -0,0 +1,431 @@
+package mmu
+
+import (
+    "testing"
+
+    "cgbemu/src/cartridge"
+)
+
+// testCartridge is a 32 KiB ROM with 8 KiB of RAM, and a ROM "bank" register at 0x2000
+// that is recorded but only changes the page mapping of 0x4000-0x7FFF.
+type testCartridge struct {
+    rom     [2][0x4000]byte
+    ram     [0x2000]byte
+    bank    int
+    writes  int
+}
+
+func (c *testCartridge) Read(address uint16) byte {
+    switch {
+    case address < 0x4000:
+        return c.rom[0][address]
+    case address < 0x8000:
+        return c.rom[c.bank][address - 0x4000]
+    default:
+        return c.ram[address - 0xA000]
+    }
+}
+
+func (c *testCartridge) Write(address uint16, value byte) {
+    switch {
+    case address < 0x8000:
+        c.writes++
+        c.bank = int(value & 0x01)
+    default:
+        c.ram[address - 0xA000] = value
+    }
+}
+
+func (c *testCartridge) Page(page byte) []byte {
+    offset := int(page) * pageSize
+    if page < 0x40 {
+        return c.rom[0][offset : offset + pageSize]
+    }
+    offset -= 0x4000
+    return c.rom[c.bank][offset : offset + pageSize]
+}
+
+func TestROMIsReadOnly(t *testing.T) {
+
+    m := New()
+    cart := &testCartridge{}
+    cart.rom[0][0x0100] = 0x42
+    m.LoadCartridge(cart)
+
+    // When
+    m.Write(0x0100, 0x99)
+
+    if m.Read(0x0100) != 0x42 {
+        t.Error("ROM should not be overwritten, read: ", m.Read(0x0100))
+    }
+
+    if cart.writes != 1 {
+        t.Error("Writes to ROM should go to the cartridge mapper, writes: ", cart.writes)
+    }
+}
+
+// TestROMPagesFollowBankSwitch verifies that the page table is refreshed after a mapper write.
+func TestROMPagesFollowBankSwitch(t *testing.T) {
+
+    m := New()
+    cart := &testCartridge{}
+    cart.rom[0][0x0000] = 0x10
+    cart.rom[1][0x0000] = 0x11
+    m.LoadCartridge(cart)
+
+    if m.Read(0x4000) != 0x10 {
+        t.Error("0x4000 should read bank 0, read: ", m.Read(0x4000))
+    }
+
+    // When
+    m.Write(0x2000, 0x01)
+
+    if m.Read(0x4000) != 0x11 {
+        t.Error("0x4000 should read bank 1, read: ", m.Read(0x4000))
+    }
+}
+
+// TestIsPlain verifies that only the pages read from the page table are reported plain.
+func TestIsPlain(t *testing.T) {
+
+    m := New()
+
+    if m.IsPlain(0x0100) {
+        t.Error("ROM should not be plain without a cartridge")
+    }
+
+    // When
+    m.LoadCartridge(&testCartridge{})
+
+    if !m.IsPlain(0x0100) || !m.IsPlain(0x4000) || !m.IsPlain(0xC000) {
+        t.Error("ROM pages and WRAM should be plain")
+    }
+
+    if m.IsPlain(0xA000) || m.IsPlain(0xFF0F) {
+        t.Error("External RAM and I/O registers should not be plain")
+    }
+}
+
+func TestExternalRAM(t *testing.T) {
+
+    m := New()
+    cart := &testCartridge{}
+    m.LoadCartridge(cart)
+
+    // When
+    m.Write(0xA123, 0x5A)
+
+    if cart.ram[0x0123] != 0x5A || m.Read(0xA123) != 0x5A {
+        t.Error("External RAM should be stored in the cartridge.")
+    }
+}
+
+// TestNoCartridgeReadsOpenBus verifies that ROM and external RAM read 0xFF without a cartridge.
+func TestNoCartridgeReadsOpenBus(t *testing.T) {
+
+    m := New()
+
+    for _, address := range []uint16{0x0000, 0x0100, 0x7FFF, 0xA000, 0xBFFF} {
+        if m.Read(address) != OpenBus {
+            t.Error("Address ", address, " should read open bus, read: ", m.Read(address))
+        }
+    }
+}
+
+// TestEchoRAM verifies that 0xE000-0xFDFF mirrors 0xC000-0xDDFF in both directions.
+func TestEchoRAM(t *testing.T) {
+
+    m := New()
+
+    // When
+    m.Write(0xC010, 0x12)
+    m.Write(0xFD00, 0x34)
+
+    if m.Read(0xE010) != 0x12 {
+        t.Error("0xE010 should mirror 0xC010, read: ", m.Read(0xE010))
+    }
+
+    if m.Read(0xDD00) != 0x34 {
+        t.Error("0xDD00 should be written through its mirror 0xFD00, read: ", m.Read(0xDD00))
+    }
+}
+
+func TestWRAMBanking(t *testing.T) {
+
+    m := New()
+
+    // Given
+    m.Write(0xD000, 0x01)
+    m.Write(SVBKAddress, 0x03)
+    m.Write(0xD000, 0x03)
+
+    // Then
+    if m.Read(0xD000) != 0x03 || m.Read(0xF000) != 0x03 {
+        t.Error("Bank 3 should be visible at 0xD000 and its mirror.")
+    }
+
+    // Bank 0 selects bank 1.
+    m.Write(SVBKAddress, 0x00)
+
+    if m.Read(0xD000) != 0x01 {
+        t.Error("Selecting bank 0 should select bank 1, read: ", m.Read(0xD000))
+    }
+
+    if m.Read(SVBKAddress) != 0xF9 {
+        t.Error("SVBK should read 0xF9, read: ", m.Read(SVBKAddress))
+    }
+}
+
+func TestVRAMBanking(t *testing.T) {
+
+    m := New()
+
+    // Given
+    m.Write(0x8000, 0xAA)
+    m.Write(VBKAddress, 0x01)
+    m.Write(0x8000, 0xBB)
+
+    // Then
+    if m.Read(0x8000) != 0xBB {
+        t.Error("Bank 1 should be visible at 0x8000, read: ", m.Read(0x8000))
+    }
+
+    m.Write(VBKAddress, 0x00)
+
+    if m.Read(0x8000) != 0xAA {
+        t.Error("Bank 0 should be visible at 0x8000, read: ", m.Read(0x8000))
+    }
+
+    if m.Read(VBKAddress) != 0xFE {
+        t.Error("VBK should read 0xFE, read: ", m.Read(VBKAddress))
+    }
+}
+
+func TestOAMAndUnusableRegion(t *testing.T) {
+
+    m := New()
+
+    // When
+    m.Write(0xFE9F, 0x77)
+    m.Write(0xFEA0, 0x77)
+
+    if m.Read(0xFE9F) != 0x77 {
+        t.Error("OAM should be writable, read: ", m.Read(0xFE9F))
+    }
+
+    if m.Read(0xFEA0) != OpenBus {
+        t.Error("Unusable region should ignore writes, read: ", m.Read(0xFEA0))
+    }
+}
+
+func TestHRAMAndIE(t *testing.T) {
+
+    m := New()
+
+    // When
+    m.Write(0xFF80, 0x01)
+    m.Write(0xFFFE, 0x02)
+    m.Write(IEAddress, 0x1F)
+
+    if m.Read(0xFF80) != 0x01 || m.Read(0xFFFE) != 0x02 {
+        t.Error("HRAM should be writable.")
+    }
+
+    if m.Read(IEAddress) != 0x1F {
+        t.Error("IE should be 0x1F, read: ", m.Read(IEAddress))
+    }
+}
+
+// TestIOHandlers verifies that registered handlers own their I/O register, and
+// unregistered ones read open bus.
+func TestIOHandlers(t *testing.T) {
+
+    m := New()
+
+    // Given
+    var written byte
+    m.RegisterIO(0xFF01, IOHandler{
+        Read:   func() byte { return 0x5C },
+        Write:  func(value byte) { written = value },
+    })
+
+    // When
+    m.Write(0xFF01, 0x81)
+
+    if written != 0x81 {
+        t.Error("Write should go to the handler, written: ", written)
+    }
+
+    if m.Read(0xFF01) != 0x5C {
+        t.Error("Read should come from the handler, read: ", m.Read(0xFF01))
+    }
+
+    if m.Read(0xFF02) != OpenBus {
+        t.Error("Unregistered I/O registers should read open bus, read: ", m.Read(0xFF02))
+    }
+}
+
+// TestInterruptFlag verifies that IF only stores 5 bits, and reads the others as 1.
+func TestInterruptFlag(t *testing.T) {
+
+    m := New()
+
+    // When
+    m.Write(IFAddress, 0x00)
+    m.RequestInterrupt(2)
+
+    if m.Read(IFAddress) != 0xE4 {
+        t.Error("IF should read 0xE4, read: ", m.Read(IFAddress))
+    }
+}
+
+func TestSpeedSwitch(t *testing.T) {
+
+    m := New()
+
+    if m.SwitchSpeed() {
+        t.Error("No speed switch should be armed.")
+    }
+
+    // When
+    m.Write(KEY1Address, 0xFF)
+
+    if m.Read(KEY1Address) != 0x7F {
+        t.Error("Only the armed bit should be writable, KEY1: ", m.Read(KEY1Address))
+    }
+
+    if !m.SwitchSpeed() || !m.IsDoubleSpeed() {
+        t.Error("The armed switch should enter double speed.")
+    }
+
+    if m.Read(KEY1Address) != 0xFE {
+        t.Error("KEY1 should read 0xFE, read: ", m.Read(KEY1Address))
+    }
+}
+
+// speedComponent records the speed it was told about.
+type speedComponent struct {
+    ticks       int
+    doubleSpeed bool
+}
+
+func (c *speedComponent) Tick(cycles int) {
+    c.ticks += cycles
+}
+
+func (c *speedComponent) SetDoubleSpeed(on bool) {
+    c.doubleSpeed = on
+}
+
+func TestComponentsFollowSpeedSwitch(t *testing.T) {
+
+    m := New()
+    c := &speedComponent{doubleSpeed: true}
+
+    // When
+    m.AddComponent(c)
+    m.Tick(3)
+
+    if c.ticks != 3 || c.doubleSpeed {
+        t.Error("Component should tick 3 cycles at normal speed, ticked: ", c.ticks, " double speed: ", c.doubleSpeed)
+    }
+
+    // When
+    m.Write(KEY1Address, 0x01)
+    m.SwitchSpeed()
+
+    if !c.doubleSpeed {
+        t.Error("Component should be told about the switch to double speed.")
+    }
+}
+
+// BenchmarkReadROM measures the page table fast path taken by instruction fetches.
+func BenchmarkReadROM(b *testing.B) {
+
+    m := New()
+    m.LoadCartridge(&testCartridge{})
+
+    var sum byte
+    for i := 0; i < b.N; i++ {
+        sum += m.Read(uint16(i) & 0x7FFF)
+    }
+    _ = sum
+}
+
+// TestRPRegister verifies the bits of RP, the LED events and the sensor.
+func TestRPRegister(t *testing.T) {
+
+    m := New()
+    var events []bool
+    m.OnIR(func(on bool) { events = append(events, on) })
+
+    if got := m.Read(RPAddress); got != 0x3E {
+        t.Errorf("RP should read 0x3E at start, got 0x%02X", got)
+    }
+
+    // When
+    m.Write(RPAddress, 0xFF)
+    m.Write(RPAddress, 0xC1)
+    m.Write(RPAddress, 0xC0)
+
+    // Then
+    if len(events) != 2 || !events[0] || events[1] {
+        t.Error("IR events should be on, off, got ", events)
+    }
+
+    // When
+    m.SetIRLight(true)
+
+    // Then
+    if got := m.Read(RPAddress); got != 0xFC {
+        t.Errorf("RP should read 0xFC when receiving light, got 0x%02X", got)
+    }
+
+    // When: reading disabled.
+    m.Write(RPAddress, 0x40)
+
+    // Then
+    if got := m.Read(RPAddress); got != 0x7E {
+        t.Errorf("RP should read 0x7E with reading disabled, got 0x%02X", got)
+    }
+}
+
+// TestRPLinksToHuC1 links the RP register to the IR port of a HuC1 cartridge, both driven
+// through IRPort.
+func TestRPLinksToHuC1(t *testing.T) {
+
+    // Given
+    rom := make([]byte, 0x8000)
+    copy(rom[0x0104:], cartridge.Logo[:])
+    rom[0x0147] = 0xFF
+    rom[0x0149] = 0x02
+    rom[0x014D] = cartridge.HeaderChecksum(rom)
+    cart, err := cartridge.New(rom)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    m := New()
+    link := func(a, b IRPort) {
+        a.OnIR(b.SetIRLight)
+        b.OnIR(a.SetIRLight)
+    }
+    link(m, cart)
+
+    // When: IR mode on the cartridge, the LED of RP on.
+    cart.Write(0x0000, 0x0E)
+    m.Write(RPAddress, 0xC1)
+
+    // Then
+    if got := cart.Read(0xA000); got != 0xC1 {
+        t.Errorf("Cartridge should see light, got 0x%02X", got)
+    }
+
+    // When
+    cart.Write(0xA000, 0x01)
+
+    // Then
+    if got := m.Read(RPAddress); got & 0x02 != 0 {
+        t.Errorf("RP should see light, got 0x%02X", got)
+    }
+}
@@ -0,0 +1,198 @@
+package scheduler
+
+import "container/heap"
+
+// Time is counted in T-cycles of the 4.194304 MHz base clock. The PPU and APU run on it
+// regardless of the CPU speed: a CPU machine cycle lasts 4 T-cycles at normal speed,
+// and 2 in CGB double speed mode.
+const (
+    NormalSpeedRatio = 4
+    DoubleSpeedRatio = 2
+)
+
+// Handler runs an event. at is the cycle the event was due, use it to schedule the next
+// occurrence without drifting: s.ScheduleAt(e, at + period).
+type Handler func(at uint64)
+
+// Event is something a component wants to happen at a given cycle, e.g. "PPU mode 2 ends"
+// or "TIMA overflows". An Event is created once and can be scheduled any number of
+// times, but is pending at most once.
+type Event struct {
+    Name    string
+    handler Handler
+
+    at      uint64
+    seq     uint64
+
+    // Position in the queue, -1 when not pending.
+    index   int
+}
+
+// NewEvent returns an Event running handler, not scheduled yet.
+func NewEvent(name string, handler Handler) *Event {
+    return &Event{Name: name, handler: handler, index: -1}
+}
+
+// Pending reports whether the event is scheduled.
+func (e *Event) Pending() bool {
+    return e.index >= 0
+}
+
+// At returns the cycle the event is scheduled for, meaningful only if it is pending.
+func (e *Event) At() uint64 {
+    return e.at
+}
+
+// Scheduler keeps a global cycle counter and a priority queue of events, and runs each
+// event when the counter reaches it.
+//
+// The CPU advances the scheduler through Tick, in machine cycles. Instead of polling every
+// component on every cycle, CPU.Run in package arc runs the CPU for MachineCyclesUntilNext
+// cycles, then lets the scheduler run what is due. Events that must see the exact machine
+// cycle of a bus access can still tick the scheduler on the bus, as an MMU component.
+type Scheduler struct {
+    now     uint64
+    ratio   uint64
+    seq     uint64
+    queue   eventQueue
+}
+
+// New returns a Scheduler at cycle 0, at normal speed, with no events.
+func New() *Scheduler {
+    return &Scheduler{ratio: NormalSpeedRatio}
+}
+
+// Now returns the current cycle.
+func (s *Scheduler) Now() uint64 {
+    return s.now
+}
+
+// Schedule schedules e to run after the given cycles from now.
+// If e is already pending it is moved, it never runs twice.
+func (s *Scheduler) Schedule(e *Event, after uint64) {
+    s.ScheduleAt(e, s.now + after)
+}
+
+// ScheduleAt schedules e to run at the given cycle, or on the next Tick if it is in the past.
+// If e is already pending it is moved, it never runs twice.
+//
+// Events due on the same cycle run in the order they were scheduled.
+func (s *Scheduler) ScheduleAt(e *Event, at uint64) {
+
+    e.at = at
+    e.seq = s.seq
+    s.seq++
+
+    if e.Pending() {
+        heap.Fix(&s.queue, e.index)
+        return
+    }
+    heap.Push(&s.queue, e)
+}
+
+// Cancel removes e from the queue, if it is pending.
+func (s *Scheduler) Cancel(e *Event) {
+    if e.Pending() {
+        heap.Remove(&s.queue, e.index)
+    }
+}
+
+// Next returns the next event to run, or nil if there are none.
+func (s *Scheduler) Next() *Event {
+    if len(s.queue) == 0 {
+        return nil
+    }
+    return s.queue[0]
+}
+
+// MachineCyclesUntilNext returns how many CPU machine cycles, at the current speed, can
+// run before the next event is due. It is at least 1, and -1 if no event is pending.
+func (s *Scheduler) MachineCyclesUntilNext() int {
+
+    next := s.Next()
+    if next == nil {
+        return -1
+    }
+    if next.at <= s.now {
+        return 1
+    }
+
+    // Round up: the event is due during that machine cycle.
+    return int((next.at - s.now + s.ratio - 1) / s.ratio)
+}
+
+// Tick advances the counter by the given CPU machine cycles, running every event due on
+// the way, in order. Handlers see Now as the cycle their event was due, or the current
+// cycle if it was scheduled in the past, so events they schedule are timed from it.
+func (s *Scheduler) Tick(cycles int) {
+    s.Advance(uint64(cycles) * s.ratio)
+}
+
+// Advance advances the counter by the given T-cycles, see Tick.
+func (s *Scheduler) Advance(tCycles uint64) {
+
+    target := s.now + tCycles
+
+    for len(s.queue) > 0 && s.queue[0].at <= target {
+
+        e := heap.Pop(&s.queue).(*Event)
+
+        // Late events run now, time never goes back.
+        if e.at > s.now {
+            s.now = e.at
+        }
+        e.handler(e.at)
+    }
+
+    s.now = target
+}
+
+// IsDoubleSpeed reports whether a machine cycle lasts DoubleSpeedRatio T-cycles.
+func (s *Scheduler) IsDoubleSpeed() bool {
+    return s.ratio == DoubleSpeedRatio
+}
+
+// SetDoubleSpeed changes the length of the machine cycles counted by Tick. Pending events
+// keep their cycle: at double speed the CPU executes twice as many machine cycles before them.
+func (s *Scheduler) SetDoubleSpeed(on bool) {
+    if on {
+        s.ratio = DoubleSpeedRatio
+    } else {
+        s.ratio = NormalSpeedRatio
+    }
+}
+
+// eventQueue is a min-heap of events ordered by cycle, then by scheduling order.
+type eventQueue []*Event
+
+func (q eventQueue) Len() int {
+    return len(q)
+}
+
+func (q eventQueue) Less(i, j int) bool {
+    if q[i].at != q[j].at {
+        return q[i].at < q[j].at
+    }
+    return q[i].seq < q[j].seq
+}
+
+func (q eventQueue) Swap(i, j int) {
+    q[i], q[j] = q[j], q[i]
+    q[i].index = i
+    q[j].index = j
+}
+
+func (q *eventQueue) Push(x any) {
+    e := x.(*Event)
+    e.index = len(*q)
+    *q = append(*q, e)
+}
+
+func (q *eventQueue) Pop() any {
+    old := *q
+    e := old[len(old) - 1]
+    old[len(old) - 1] = nil
+    e.index = -1
+    *q = old[:len(old) - 1]
+    return e
+}
@@ -0,0 +1,223 @@
+package scheduler
+
+import "testing"
+
+// recorder returns a Handler appending the event name and due cycle to log.
+func recorder(log *[]string, name string) Handler {
+    return func(at uint64) {
+        *log = append(*log, name)
+    }
+}
+
+func TestEventsRunInOrder(t *testing.T) {
+
+    // Given
+    s := New()
+    var log []string
+    a := NewEvent("a", recorder(&log, "a"))
+    b := NewEvent("b", recorder(&log, "b"))
+    c := NewEvent("c", recorder(&log, "c"))
+
+    s.Schedule(c, 12)
+    s.Schedule(a, 4)
+    s.Schedule(b, 12)
+
+    // When, 3 machine cycles at normal speed are 12 T-cycles.
+    s.Tick(3)
+
+    // Then, c and b are due together and run in scheduling order.
+    if len(log) != 3 || log[0] != "a" || log[1] != "c" || log[2] != "b" {
+        t.Error("Events should run as a, c, b, ran: ", log)
+    }
+    if s.Now() != 12 || a.Pending() {
+        t.Error("Now should be 12 with no event pending, now: ", s.Now())
+    }
+}
+
+func TestEventsNotDueDoNotRun(t *testing.T) {
+
+    // Given
+    s := New()
+    ran := false
+    e := NewEvent("e", func(at uint64) { ran = true })
+    s.Schedule(e, 5)
+
+    // When
+    s.Tick(1)
+
+    // Then
+    if ran || !e.Pending() {
+        t.Error("The event is due on cycle 5 and should not run at cycle 4.")
+    }
+}
+
+func TestRescheduleMovesEvent(t *testing.T) {
+
+    // Given
+    s := New()
+    runs := 0
+    e := NewEvent("e", func(at uint64) { runs++ })
+    s.Schedule(e, 4)
+
+    // When
+    s.Schedule(e, 100)
+    s.Tick(1)
+
+    // Then
+    if runs != 0 || e.At() != 100 {
+        t.Error("The event should have moved to cycle 100, runs: ", runs, " at: ", e.At())
+    }
+
+    s.Tick(30)
+    if runs != 1 {
+        t.Error("The event should run once, runs: ", runs)
+    }
+}
+
+func TestCancel(t *testing.T) {
+
+    // Given
+    s := New()
+    ran := false
+    e := NewEvent("e", func(at uint64) { ran = true })
+    s.Schedule(e, 4)
+
+    // When
+    s.Cancel(e)
+    s.Tick(10)
+
+    // Then
+    if ran || e.Pending() || s.Next() != nil {
+        t.Error("A cancelled event should not run.")
+    }
+}
+
+// TestPeriodicEventDoesNotDrift verifies that an event rescheduling itself from its due
+// cycle keeps its period, even when ticked in steps not aligned with it.
+func TestPeriodicEventDoesNotDrift(t *testing.T) {
+
+    // Given
+    s := New()
+    var due []uint64
+    var e *Event
+    e = NewEvent("timer", func(at uint64) {
+        due = append(due, at)
+        s.ScheduleAt(e, at + 10)
+    })
+    s.Schedule(e, 10)
+
+    // When, 3 machine cycles (12 T-cycles) at a time.
+    for i := 0; i < 10; i++ {
+        s.Tick(3)
+    }
+
+    // Then
+    if len(due) != 12 {
+        t.Fatal("The event should have run 12 times in 120 cycles, ran: ", len(due))
+    }
+    for i, at := range due {
+        if at != uint64(i + 1) * 10 {
+            t.Error("Run ", i, " was due at ", at, ", expected ", (i + 1) * 10)
+        }
+    }
+}
+
+func TestHandlerSeesDueCycle(t *testing.T) {
+
+    // Given
+    s := New()
+    var now uint64
+    e := NewEvent("e", func(at uint64) { now = s.Now() })
+    s.Schedule(e, 6)
+
+    // When
+    s.Tick(4)
+
+    // Then
+    if now != 6 || s.Now() != 16 {
+        t.Error("The handler should run at cycle 6, ran at ", now, " now: ", s.Now())
+    }
+}
+
+func TestMachineCyclesUntilNext(t *testing.T) {
+
+    // Given
+    s := New()
+    e := NewEvent("e", func(at uint64) {})
+
+    if s.MachineCyclesUntilNext() != -1 {
+        t.Error("No event is pending.")
+    }
+
+    s.Schedule(e, 10)
+
+    // Then, cycle 10 falls in the third machine cycle at normal speed.
+    if s.MachineCyclesUntilNext() != 3 {
+        t.Error("3 machine cycles expected, got ", s.MachineCyclesUntilNext())
+    }
+
+    // When
+    s.SetDoubleSpeed(true)
+
+    // Then, machine cycles are twice as short.
+    if s.MachineCyclesUntilNext() != 5 {
+        t.Error("5 machine cycles expected at double speed, got ", s.MachineCyclesUntilNext())
+    }
+}
+
+func TestDoubleSpeedRatio(t *testing.T) {
+
+    // Given
+    s := New()
+    ran := false
+    e := NewEvent("e", func(at uint64) { ran = true })
+    s.Schedule(e, 8)
+    s.SetDoubleSpeed(true)
+
+    // When, 3 machine cycles at double speed are 6 T-cycles.
+    s.Tick(3)
+
+    // Then
+    if ran || s.Now() != 6 {
+        t.Error("The event should not have run at cycle ", s.Now())
+    }
+
+    s.Tick(1)
+    if !ran {
+        t.Error("The event should run on cycle 8.")
+    }
+}
+
+func TestEventScheduledInThePastRunsOnNextTick(t *testing.T) {
+
+    // Given
+    s := New()
+    s.Tick(10)
+    ran := false
+    e := NewEvent("e", func(at uint64) { ran = true })
+    s.ScheduleAt(e, 0)
+
+    if s.MachineCyclesUntilNext() != 1 {
+        t.Error("An overdue event should be due on the next machine cycle.")
+    }
+
+    // When
+    s.Tick(1)
+
+    // Then
+    if !ran {
+        t.Error("An overdue event should run on the next Tick.")
+    }
+}
+
+func BenchmarkTick(b *testing.B) {
+
+    s := New()
+    var e *Event
+    e = NewEvent("periodic", func(at uint64) { s.ScheduleAt(e, at + 456) })
+    s.Schedule(e, 456)
+
+    for i := 0; i < b.N; i++ {
+        s.Tick(1)
+    }
+}